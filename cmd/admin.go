@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"code.gitea.io/tea/cmd/admin/users"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 	"github.com/urfave/cli/v2"
@@ -42,12 +43,15 @@ var cmdAdminUsers = cli.Command{
 }
 
 func runAdminUserDetail(cmd *cli.Context, u string) error {
-	ctx := context.InitCommand(cmd)
-	client := ctx.Login.Client()
-	user, _, err := client.GetUserInfo(u)
+	ctx, err := context.InitCommand(cmd)
 	if err != nil {
 		return err
 	}
+	client := ctx.Login.Client()
+	user, resp, err := client.GetUserInfo(u)
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
 
 	print.UserDetails(user)
 	return nil
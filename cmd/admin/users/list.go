@@ -5,6 +5,7 @@ package users
 
 import (
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -32,7 +33,10 @@ var CmdUserList = cli.Command{
 
 // RunUserList list users
 func RunUserList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	fields, err := userFieldsFlag.GetValues(cmd)
 	if err != nil {
@@ -40,11 +44,11 @@ func RunUserList(cmd *cli.Context) error {
 	}
 
 	client := ctx.Login.Client()
-	users, _, err := client.AdminListUsers(gitea.AdminListUsersOptions{
+	users, resp, err := client.AdminListUsers(gitea.AdminListUsersOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.UserList(users, ctx.Output, fields)
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 
 	"github.com/urfave/cli/v2"
@@ -26,8 +27,13 @@ var CmdReleaseAttachmentCreate = cli.Command{
 }
 
 func runReleaseAttachmentCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	if ctx.Args().Len() < 2 {
@@ -52,9 +58,9 @@ func runReleaseAttachmentCreate(cmd *cli.Context) error {
 
 		filePath := filepath.Base(asset)
 
-		if _, _, err = ctx.Login.Client().CreateReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, file, filePath); err != nil {
+		if _, resp, err := ctx.Login.Client().CreateReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, file, filePath); err != nil {
 			file.Close()
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 
 		file.Close()
@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -31,8 +33,13 @@ var CmdReleaseAttachmentDelete = cli.Command{
 }
 
 func runReleaseAttachmentDelete(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	if ctx.Args().Len() < 2 {
@@ -44,7 +51,7 @@ func runReleaseAttachmentDelete(cmd *cli.Context) error {
 		return fmt.Errorf("Release tag needed to delete attachment")
 	}
 
-	if !ctx.Bool("confirm") {
+	if !ctx.Bool("confirm") && !utils.AssumeYes() {
 		fmt.Println("Are you sure? Please confirm with -y or --confirm.")
 		return nil
 	}
@@ -54,11 +61,11 @@ func runReleaseAttachmentDelete(cmd *cli.Context) error {
 		return err
 	}
 
-	existing, _, err := client.ListReleaseAttachments(ctx.Owner, ctx.Repo, release.ID, gitea.ListReleaseAttachmentsOptions{
+	existing, resp, err := client.ListReleaseAttachments(ctx.Owner, ctx.Repo, release.ID, gitea.ListReleaseAttachmentsOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	for _, name := range ctx.Args().Slice()[1:] {
@@ -72,9 +79,9 @@ func runReleaseAttachmentDelete(cmd *cli.Context) error {
 			return fmt.Errorf("Release does not have attachment named '%s'", name)
 		}
 
-		_, err = client.DeleteReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, attachment.ID)
+		resp, err := client.DeleteReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, attachment.ID)
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 	}
 
@@ -82,11 +89,11 @@ func runReleaseAttachmentDelete(cmd *cli.Context) error {
 }
 
 func getReleaseAttachmentByName(owner, repo string, release int64, name string, client *gitea.Client) (*gitea.Attachment, error) {
-	al, _, err := client.ListReleaseAttachments(owner, repo, release, gitea.ListReleaseAttachmentsOptions{
+	al, resp, err := client.ListReleaseAttachments(owner, repo, release, gitea.ListReleaseAttachmentsOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return nil, err
+		return nil, config.ClassifyAPIError(resp, err)
 	}
 	if len(al) == 0 {
 		return nil, fmt.Errorf("Release does not have any attachments")
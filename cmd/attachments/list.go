@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -30,8 +31,13 @@ var CmdReleaseAttachmentList = cli.Command{
 
 // RunReleaseAttachmentList list release attachments
 func RunReleaseAttachmentList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	tag := ctx.Args().First()
@@ -44,11 +50,11 @@ func RunReleaseAttachmentList(cmd *cli.Context) error {
 		return err
 	}
 
-	attachments, _, err := ctx.Login.Client().ListReleaseAttachments(ctx.Owner, ctx.Repo, release.ID, gitea.ListReleaseAttachmentsOptions{
+	attachments, resp, err := ctx.Login.Client().ListReleaseAttachments(ctx.Owner, ctx.Repo, release.ID, gitea.ListReleaseAttachmentsOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.ReleaseAttachmentsList(attachments, ctx.Output)
@@ -56,11 +62,11 @@ func RunReleaseAttachmentList(cmd *cli.Context) error {
 }
 
 func getReleaseByTag(owner, repo, tag string, client *gitea.Client) (*gitea.Release, error) {
-	rl, _, err := client.ListReleases(owner, repo, gitea.ListReleasesOptions{
+	rl, resp, err := client.ListReleases(owner, repo, gitea.ListReleasesOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return nil, err
+		return nil, config.ClassifyAPIError(resp, err)
 	}
 	if len(rl) == 0 {
 		return nil, fmt.Errorf("Repo does not have any release")
@@ -5,6 +5,7 @@ package branches
 
 import (
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -36,8 +37,13 @@ var CmdBranchesList = cli.Command{
 
 // RunBranchesList list branches
 func RunBranchesList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	owner := ctx.Owner
 	if ctx.IsSet("owner") {
@@ -46,21 +52,20 @@ func RunBranchesList(cmd *cli.Context) error {
 
 	var branches []*gitea.Branch
 	var protections []*gitea.BranchProtection
-	var err error
-	branches, _, err = ctx.Login.Client().ListRepoBranches(owner, ctx.Repo, gitea.ListRepoBranchesOptions{
+	branches, resp, err := ctx.Login.Client().ListRepoBranches(owner, ctx.Repo, gitea.ListRepoBranchesOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	protections, _, err = ctx.Login.Client().ListBranchProtections(owner, ctx.Repo, gitea.ListBranchProtectionsOptions{
+	protections, resp, err = ctx.Login.Client().ListBranchProtections(owner, ctx.Repo, gitea.ListBranchProtectionsOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	fields, err := branchFieldsFlag.GetValues(cmd)
@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -44,8 +46,13 @@ var CmdBranchesUnprotect = cli.Command{
 
 // RunBranchesProtect function to protect/unprotect a list of branches
 func RunBranchesProtect(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	if !cmd.Args().Present() {
 		return fmt.Errorf("must specify at least one branch")
@@ -59,9 +66,10 @@ func RunBranchesProtect(cmd *cli.Context) error {
 	for _, branch := range ctx.Args().Slice() {
 
 		var err error
+		var resp *gitea.Response
 		command := ctx.Command.Name
 		if command == "protect" {
-			_, _, err = ctx.Login.Client().CreateBranchProtection(owner, ctx.Repo, gitea.CreateBranchProtectionOption{
+			_, resp, err = ctx.Login.Client().CreateBranchProtection(owner, ctx.Repo, gitea.CreateBranchProtectionOption{
 				BranchName:                    branch,
 				RuleName:                      "",
 				EnablePush:                    false,
@@ -87,13 +95,13 @@ func RunBranchesProtect(cmd *cli.Context) error {
 				UnprotectedFilePatterns:       "",
 			})
 		} else if command == "unprotect" {
-			_, err = ctx.Login.Client().DeleteBranchProtection(owner, ctx.Repo, branch)
+			resp, err = ctx.Login.Client().DeleteBranchProtection(owner, ctx.Repo, branch)
 		} else {
 			return fmt.Errorf("command %s is not supported", command)
 		}
 
-		if err != nil {
-			return err
+		if err != nil && !utils.IsDryRun(err) {
+			return config.ClassifyAPIError(resp, err)
 		}
 	}
 
@@ -4,8 +4,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"code.gitea.io/tea/cmd/flags"
 	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
@@ -46,7 +44,10 @@ When a host is specified in the repo-slug, it will override the login specified
 }
 
 func runRepoClone(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	args := ctx.Args()
 	if args.Len() < 1 {
@@ -69,9 +70,11 @@ func runRepoClone(cmd *cli.Context) error {
 
 	owner, repo = utils.GetOwnerAndRepo(url.Path, login.User)
 	if url.Host != "" {
-		login = config.GetLoginByHost(url.Host)
+		if login, err = config.GetLoginByHost(url.Host); err != nil {
+			return err
+		}
 		if login == nil {
-			return fmt.Errorf("No login configured matching host '%s', run `tea login add` first", url.Host)
+			return utils.NewNotExistErrorf("No login configured matching host '%s', run `tea login add` first", url.Host)
 		}
 	}
 
@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"code.gitea.io/tea/cmd/flags"
@@ -13,10 +14,9 @@ import (
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
-	"code.gitea.io/tea/modules/utils"
+	"code.gitea.io/tea/modules/task"
 
 	"code.gitea.io/sdk/gitea"
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/urfave/cli/v2"
 )
 
@@ -26,25 +26,38 @@ var CmdAddComment = cli.Command{
 	Aliases:     []string{"c"},
 	Category:    catEntities,
 	Usage:       "Add a comment to an issue / pr",
-	Description: "Add a comment to an issue / pr",
+	Description: "Add a comment to an issue / pr. Attached files are linked at the end of the comment",
 	ArgsUsage:   "<issue / pr index> [<comment body>]",
 	Action:      runAddComment,
-	Flags:       flags.AllDefaultFlags,
+	Flags: append([]cli.Flag{
+		&cli.StringSliceFlag{
+			Name:    "attach",
+			Aliases: []string{"a"},
+			Usage:   "File to attach to the comment. May be repeated",
+		},
+	}, flags.AllDefaultFlags...),
 }
 
 func runAddComment(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-
-	args := ctx.Args()
-	if args.Len() == 0 {
-		return fmt.Errorf("Please specify issue / pr index")
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
 	}
 
-	idx, err := utils.ArgToIndex(ctx.Args().First())
-	if err != nil {
+	var idx int64
+	if ctx.Args().Present() {
+		if idx, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
 		return err
 	}
+	if !ctx.Args().Present() {
+		if idx, err = interact.SelectIssue(ctx, gitea.IssueTypeAll, gitea.StateOpen); err != nil {
+			return err
+		}
+	}
 
 	body := strings.Join(ctx.Args().Tail(), " ")
 	if interact.IsStdinPiped() {
@@ -55,11 +68,11 @@ func runAddComment(cmd *cli.Context) error {
 			body = strings.Join([]string{body, string(bodyStdin)}, "\n\n")
 		}
 	} else if len(body) == 0 {
-		if err = survey.AskOne(interact.NewMultiline(interact.Multiline{
+		if err = interact.AskOne(interact.NewMultiline(interact.Multiline{
 			Message:   "Comment:",
 			Syntax:    "md",
 			UseEditor: config.GetPreferences().Editor,
-		}), &body); err != nil {
+		}), &body, "<comment body>"); err != nil {
 			return err
 		}
 	}
@@ -68,12 +81,37 @@ func runAddComment(cmd *cli.Context) error {
 		return fmt.Errorf("No comment body provided")
 	}
 
+	// check attachments before creating the comment, so it isn't left incomplete
+	for _, file := range ctx.StringSlice("attach") {
+		if _, err := os.Stat(file); err != nil {
+			return err
+		}
+	}
+
 	client := ctx.Login.Client()
-	comment, _, err := client.CreateIssueComment(ctx.Owner, ctx.Repo, idx, gitea.CreateIssueCommentOption{
+	comment, resp, err := client.CreateIssueComment(ctx.Owner, ctx.Repo, idx, gitea.CreateIssueCommentOption{
 		Body: body,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
+	}
+
+	if files := ctx.StringSlice("attach"); len(files) != 0 {
+		links := make([]string, 0, len(files))
+		target := task.AttachmentTarget{Owner: ctx.Owner, Repo: ctx.Repo, CommentID: comment.ID}
+		for _, file := range files {
+			a, err := task.UploadAttachment(ctx.Login, target, file)
+			if err != nil {
+				return err
+			}
+			links = append(links, task.AttachmentMarkdown(a))
+		}
+		comment, resp, err = client.EditIssueComment(ctx.Owner, ctx.Repo, comment.ID, gitea.EditIssueCommentOption{
+			Body: body + "\n\n" + strings.Join(links, "\n"),
+		})
+		if err != nil {
+			return config.ClassifyAPIError(resp, err)
+		}
 	}
 
 	print.Comment(comment)
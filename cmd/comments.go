@@ -0,0 +1,27 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"code.gitea.io/tea/cmd/comments"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdComments is the main command to manage comments on issues & pulls
+var CmdComments = cli.Command{
+	Name:     "comments",
+	Category: catEntities,
+	Usage:    "List, edit and delete comments on issues / prs",
+	Description: `Lists the comments of an issue / pr when called with its index.
+To add a comment, use 'tea comment'.`,
+	ArgsUsage: "[<issue / pr index>]",
+	Action:    comments.RunCommentsList,
+	Subcommands: []*cli.Command{
+		&comments.CmdCommentsList,
+		&comments.CmdCommentsEdit,
+		&comments.CmdCommentsDelete,
+	},
+	Flags: comments.CmdCommentsList.Flags,
+}
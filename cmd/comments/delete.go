@@ -0,0 +1,65 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package comments
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdCommentsDelete represents a sub command of comments to delete comments
+var CmdCommentsDelete = cli.Command{
+	Name:        "delete",
+	Aliases:     []string{"rm"},
+	Usage:       "Delete one or more comments",
+	Description: `Delete one or more comments`,
+	ArgsUsage:   "<comment id> [<comment id>...]",
+	Action:      runCommentsDelete,
+	Flags: append([]cli.Flag{
+		&cli.BoolFlag{
+			Name:    "confirm",
+			Aliases: []string{"y"},
+			Usage:   "Confirm deletion (required)",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runCommentsDelete(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	if !ctx.Args().Present() {
+		return utils.NewInvalidArgumentErrorf("Must specify a comment id")
+	}
+	ids := make([]int64, ctx.Args().Len())
+	for i, arg := range ctx.Args().Slice() {
+		if ids[i], err = commentIDArg(arg); err != nil {
+			return err
+		}
+	}
+
+	if !ctx.Bool("confirm") && !utils.AssumeYes() {
+		fmt.Println("Are you sure? Please confirm with -y or --confirm.")
+		return nil
+	}
+
+	client := ctx.Login.Client()
+	for _, id := range ids {
+		resp, err := client.DeleteIssueComment(ctx.Owner, ctx.Repo, id)
+		if err != nil && !utils.IsDryRun(err) {
+			return config.ClassifyAPIError(resp, err)
+		}
+	}
+	return nil
+}
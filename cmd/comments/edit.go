@@ -0,0 +1,106 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package comments
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdCommentsEdit represents a sub command of comments to edit a comment
+var CmdCommentsEdit = cli.Command{
+	Name:    "edit",
+	Aliases: []string{"e"},
+	Usage:   "Edit a comment",
+	Description: `Edit a comment. The new body is taken from --body or stdin,
+otherwise the current body is opened in $VISUAL or $EDITOR.`,
+	ArgsUsage: "<comment id>",
+	Action:    runCommentsEdit,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:    "body",
+			Aliases: []string{"b"},
+			Usage:   "New body of the comment",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runCommentsEdit(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	id, err := commentIDArg(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client := ctx.Login.Client()
+	comment, resp, err := client.GetIssueComment(ctx.Owner, ctx.Repo, id)
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+
+	var body string
+	switch {
+	case ctx.IsSet("body"):
+		body = ctx.String("body")
+	case interact.IsStdinPiped():
+		data, err := io.ReadAll(ctx.App.Reader)
+		if err != nil {
+			return err
+		}
+		body = string(data)
+	default:
+		if body, err = interact.EditText(comment.Body, "--body"); err != nil {
+			return err
+		}
+	}
+
+	if len(strings.TrimSpace(body)) == 0 {
+		return utils.NewInvalidArgumentErrorf("No comment body provided")
+	}
+	if body == comment.Body {
+		fmt.Println("Comment is unchanged")
+		return nil
+	}
+
+	comment, resp, err = client.EditIssueComment(ctx.Owner, ctx.Repo, id, gitea.EditIssueCommentOption{Body: body})
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+	print.Comment(comment)
+	return nil
+}
+
+// commentIDArg parses arg as comment ID, which may also be given as URL of the comment
+func commentIDArg(arg string) (int64, error) {
+	if len(arg) == 0 {
+		return 0, utils.NewInvalidArgumentErrorf("Must specify a comment id")
+	}
+	const urlPrefix = "#issuecomment-"
+	if i := strings.LastIndex(arg, urlPrefix); i >= 0 {
+		arg = arg[i+len(urlPrefix):]
+	}
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, utils.NewInvalidArgumentErrorf("invalid comment id '%s'", arg)
+	}
+	return id, nil
+}
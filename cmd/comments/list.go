@@ -0,0 +1,95 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package comments
+
+import (
+	"time"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/araddon/dateparse"
+	"github.com/urfave/cli/v2"
+)
+
+var commentFieldsFlag = flags.FieldsFlag(print.CommentFields, []string{
+	"id", "author", "created", "updated", "body",
+})
+
+// CmdCommentsList represents a sub command of comments to list comments
+var CmdCommentsList = cli.Command{
+	Name:    "list",
+	Aliases: []string{"ls"},
+	Usage:   "List comments of an issue / pr",
+	Description: `List comments of an issue / pr, or of all issues & prs of the repo
+if no index is given.`,
+	ArgsUsage: "[<issue / pr index>]",
+	Action:    RunCommentsList,
+	Flags: append([]cli.Flag{
+		commentFieldsFlag,
+		&cli.StringFlag{
+			Name:    "since",
+			Aliases: []string{"s"},
+			Usage:   "Only list comments updated after the given time",
+		},
+		&flags.PaginationPageFlag,
+		&flags.PaginationLimitFlag,
+	}, flags.AllDefaultFlags...),
+}
+
+// RunCommentsList lists comments
+func RunCommentsList(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	var since time.Time
+	if ctx.IsSet("since") {
+		if since, err = dateparse.ParseLocal(ctx.String("since")); err != nil {
+			return utils.NewInvalidArgumentErrorf("invalid --since: %v", err)
+		}
+	}
+	opts := gitea.ListIssueCommentOptions{
+		ListOptions: ctx.GetListOptions(),
+		Since:       since,
+	}
+
+	var idx int64
+	switch ctx.Args().Len() {
+	case 0:
+	case 1:
+		if idx, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+			return err
+		}
+	default:
+		return utils.NewInvalidArgumentErrorf("Must specify a single issue / pr index")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+
+	client := ctx.Login.Client()
+	var comments []*gitea.Comment
+	var resp *gitea.Response
+	if ctx.Args().Len() == 0 {
+		comments, resp, err = client.ListRepoIssueComments(ctx.Owner, ctx.Repo, opts)
+	} else {
+		comments, resp, err = client.ListIssueComments(ctx.Owner, ctx.Repo, idx, opts)
+	}
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+
+	fields, err := commentFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+	print.CommentsList(comments, ctx.Output, fields)
+	return nil
+}
@@ -4,6 +4,8 @@
 package flags
 
 import (
+	"code.gitea.io/tea/modules/utils"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -49,6 +51,46 @@ var PaginationLimitFlag = cli.StringFlag{
 	Usage:   "specify limit of items per page",
 }
 
+// NoInputFlag disables all interactive prompts
+var NoInputFlag = cli.BoolFlag{
+	Name:    "no-input",
+	EnvVars: []string{"TEA_NO_INPUT"},
+	Usage:   "Never prompt for input, fail with an error naming the missing flag instead",
+}
+
+// YesFlag auto-confirms destructive actions
+var YesFlag = cli.BoolFlag{
+	Name:    "yes",
+	Aliases: []string{"y"},
+	Usage:   "Confirm destructive actions like deletions without asking",
+}
+
+// DryRunFlag prints mutations instead of applying them
+var DryRunFlag = cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "Print mutating API requests and git operations instead of executing them",
+}
+
+// GlobalFlags defines flags that are available on the application level,
+// and apply to all commands. They are applied by ApplyGlobalFlags. LoginsFlags
+// are only supported by the commands which define them as well.
+var GlobalFlags = []cli.Flag{
+	&NoInputFlag,
+	&YesFlag,
+	&DryRunFlag,
+	&AllLoginsFlag,
+	&LoginsListFlag,
+}
+
+// ApplyGlobalFlags applies the values of GlobalFlags, to be run before any command.
+func ApplyGlobalFlags(ctx *cli.Context) error {
+	utils.SetNoInput(ctx.Bool(NoInputFlag.Name))
+	utils.SetAssumeYes(ctx.Bool(YesFlag.Name))
+	utils.SetDryRun(ctx.Bool(DryRunFlag.Name))
+	utils.SetGlobalLogins(ctx.Bool(AllLoginsFlag.Name), ctx.String(LoginsListFlag.Name))
+	return nil
+}
+
 // LoginOutputFlags defines login and output flags that should
 // added to all subcommands and appended to the flags of the
 // subcommand to work around issue and provide --login and --output:
@@ -4,13 +4,13 @@
 package flags
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/araddon/dateparse"
 	"github.com/urfave/cli/v2"
@@ -35,9 +35,59 @@ var LabelFilterFlag = NewCsvFlag(
 	"labels to match issues against",
 	[]string{"L"}, nil, nil)
 
+// PRSortValues are the orders in which pull requests can be listed
+var PRSortValues = []string{"oldest", "recentupdate", "leastupdate", "mostcomment", "leastcomment", "priority"}
+
 // PRListingFlags defines flags that should be available on pr listing flags.
 var PRListingFlags = append([]cli.Flag{
 	&StateFlag,
+	&cli.StringFlag{
+		Name:    "author",
+		Aliases: []string{"A"},
+		Usage:   "Filter by author, '@me' for the login user",
+	},
+	&cli.StringFlag{
+		Name:  "reviewer",
+		Usage: "Filter by a user who reviewed, '@me' for the login user",
+	},
+	&cli.StringFlag{
+		Name:  "review-requested",
+		Usage: "Filter by a user whose review is requested, '@me' for the login user",
+	},
+	LabelFilterFlag,
+	&cli.StringFlag{
+		Name:    "milestone",
+		Aliases: []string{"m"},
+		Usage:   "Filter by milestone name",
+	},
+	&cli.StringFlag{
+		Name:  "base",
+		Usage: "Filter by base (target) branch",
+	},
+	&cli.StringFlag{
+		Name:  "head",
+		Usage: "Filter by head (source) branch",
+	},
+	&cli.BoolFlag{
+		Name:  "draft",
+		Usage: "Only show work in progress, or none with --draft=false",
+	},
+	&cli.BoolFlag{
+		Name:  "mergeable",
+		Usage: "Only show open PRs without conflicts",
+	},
+	&cli.BoolFlag{
+		Name:  "conflicting",
+		Usage: "Only show open PRs with conflicts",
+	},
+	&cli.StringFlag{
+		Name:  "ci",
+		Usage: "Filter by CI status (success|pending|failure|error|warning|none)",
+	},
+	&cli.StringFlag{
+		Name:  "sort",
+		Usage: "Sort order (" + strings.Join(PRSortValues, "|") + ")",
+	},
 	&PaginationPageFlag,
 	&PaginationLimitFlag,
 }, AllDefaultFlags...)
@@ -88,6 +138,105 @@ var IssueListingFlags = append([]cli.Flag{
 	&PaginationLimitFlag,
 }, AllDefaultFlags...)
 
+// IssueWhereFlags select the issues to edit in bulk. They match the filters of IssueListingFlags.
+var IssueWhereFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "where-state",
+		Usage: "Edit issues in this state (all|open|closed)",
+		Value: "open",
+	},
+	&cli.StringFlag{
+		Name:  "where-keyword",
+		Usage: "Edit issues matching this search string",
+	},
+	&cli.StringFlag{
+		Name:  "where-labels",
+		Usage: "Edit issues with these comma-separated labels",
+	},
+	&cli.StringFlag{
+		Name:    "where-milestone",
+		Aliases: []string{"where-milestones"},
+		Usage:   "Edit issues in these comma-separated milestones",
+	},
+	&cli.StringFlag{
+		Name:  "where-author",
+		Usage: "Edit issues created by this user",
+	},
+	&cli.StringFlag{
+		Name:  "where-assignee",
+		Usage: "Edit issues assigned to this user",
+	},
+	&cli.StringFlag{
+		Name:  "where-mentions",
+		Usage: "Edit issues mentioning this user",
+	},
+	&cli.StringFlag{
+		Name:  "where-from",
+		Usage: "Edit issues with activity after this date",
+	},
+	&cli.StringFlag{
+		Name:  "where-until",
+		Usage: "Edit issues with activity before this date",
+	},
+}
+
+// GetIssueWhereOptions parses IssueWhereFlags into a filter for issues.
+// It returns nil if none of the flags is set.
+func GetIssueWhereOptions(ctx *context.TeaContext) (*gitea.ListIssueOption, error) {
+	isSet := false
+	for _, f := range IssueWhereFlags {
+		if ctx.IsSet(f.Names()[0]) {
+			isSet = true
+		}
+	}
+	if !isSet {
+		return nil, nil
+	}
+
+	state, err := ParseState(ctx.String("where-state"))
+	if err != nil {
+		return nil, err
+	}
+	opts := gitea.ListIssueOption{
+		State:       state,
+		Type:        gitea.IssueTypeIssue,
+		KeyWord:     ctx.String("where-keyword"),
+		CreatedBy:   ctx.String("where-author"),
+		AssignedBy:  ctx.String("where-assignee"),
+		MentionedBy: ctx.String("where-mentions"),
+	}
+	if labels := ctx.String("where-labels"); len(labels) != 0 {
+		opts.Labels = strings.Split(labels, ",")
+	}
+	if milestones := ctx.String("where-milestone"); len(milestones) != 0 {
+		opts.Milestones = strings.Split(milestones, ",")
+	}
+	if ctx.IsSet("where-from") {
+		if opts.Since, err = dateparse.ParseLocal(ctx.String("where-from")); err != nil {
+			return nil, err
+		}
+	}
+	if ctx.IsSet("where-until") {
+		if opts.Before, err = dateparse.ParseLocal(ctx.String("where-until")); err != nil {
+			return nil, err
+		}
+	}
+	return &opts, nil
+}
+
+// ParseState parses the value of a state filter flag, defaulting to open
+func ParseState(state string) (gitea.StateType, error) {
+	switch state {
+	case "all":
+		return gitea.StateAll, nil
+	case "", "open":
+		return gitea.StateOpen, nil
+	case "closed":
+		return gitea.StateClosed, nil
+	}
+	return "", utils.NewInvalidArgumentErrorf("unknown state '%s'", state)
+}
+
 // issuePRFlags defines shared flags between flags IssuePRCreateFlags and IssuePREditFlags
 var issuePRFlags = append([]cli.Flag{
 	&cli.StringFlag{
@@ -127,6 +276,15 @@ var IssuePRCreateFlags = append([]cli.Flag{
 		Aliases: []string{"L"},
 		Usage:   "Comma-separated list of labels to assign",
 	},
+	&cli.StringFlag{
+		Name:  "template",
+		Usage: "Name or path of the issue / PR template to start from",
+	},
+	&cli.StringFlag{
+		Name: "file",
+		Usage: "Markdown file with YAML front matter to read title, properties & description from, '-' for stdin. " +
+			"Each front matter block starts a new item",
+	},
 }, issuePRFlags...)
 
 // GetIssuePRCreateFlags parses all IssuePREditFlags
@@ -134,7 +292,7 @@ func GetIssuePRCreateFlags(ctx *context.TeaContext) (*gitea.CreateIssueOption, e
 	opts := gitea.CreateIssueOption{
 		Title:     ctx.String("title"),
 		Body:      ctx.String("description"),
-		Assignees: strings.Split(ctx.String("assignees"), ","),
+		Assignees: ParseUserList(ctx, ctx.String("assignees")),
 	}
 	var err error
 
@@ -163,9 +321,9 @@ func GetIssuePRCreateFlags(ctx *context.TeaContext) (*gitea.CreateIssueOption, e
 		if client == nil {
 			client = ctx.Login.Client()
 		}
-		ms, _, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, milestoneName)
+		ms, err := task.GetMilestoneByName(client, ctx.Owner, ctx.Repo, milestoneName)
 		if err != nil {
-			return nil, fmt.Errorf("Milestone '%s' not found", milestoneName)
+			return nil, err
 		}
 		opts.Milestone = ms.ID
 	}
@@ -178,7 +336,15 @@ var IssuePREditFlags = append([]cli.Flag{
 	&cli.StringFlag{
 		Name:    "add-assignees",
 		Aliases: []string{"a"},
-		Usage:   "Comma-separated list of usernames to assign",
+		Usage:   "Comma-separated list of usernames to assign, in addition to the current assignees. '@me' is the login user",
+	},
+	&cli.StringFlag{
+		Name:  "remove-assignees",
+		Usage: "Comma-separated list of usernames to unassign",
+	},
+	&cli.StringFlag{
+		Name:  "set-assignees",
+		Usage: "Comma-separated list of usernames replacing the current assignees, empty to unassign everyone",
 	},
 	&cli.StringFlag{
 		Name:    "add-labels",
@@ -222,9 +388,17 @@ func GetIssuePREditFlags(ctx *context.TeaContext) (*task.EditIssueOption, error)
 			opts.Deadline = &t
 		}
 	}
+	if ctx.IsSet("set-assignees") {
+		if ctx.IsSet("add-assignees") || ctx.IsSet("remove-assignees") {
+			return nil, utils.NewInvalidArgumentErrorf("--set-assignees can't be combined with --add-assignees or --remove-assignees")
+		}
+		opts.SetAssignees = ParseUserList(ctx, ctx.String("set-assignees"))
+	}
 	if ctx.IsSet("add-assignees") {
-		val := ctx.String("add-assignees")
-		opts.AddAssignees = strings.Split(val, ",")
+		opts.AddAssignees = ParseUserList(ctx, ctx.String("add-assignees"))
+	}
+	if ctx.IsSet("remove-assignees") {
+		opts.RemoveAssignees = ParseUserList(ctx, ctx.String("remove-assignees"))
 	}
 	if ctx.IsSet("add-labels") {
 		val := ctx.String("add-labels")
@@ -236,3 +410,20 @@ func GetIssuePREditFlags(ctx *context.TeaContext) (*task.EditIssueOption, error)
 	}
 	return &opts, nil
 }
+
+// ParseUserList splits a comma-separated list of usernames, dropping empty
+// entries and resolving '@me' to the user of the active login.
+// The result is never nil, so an empty list can be told apart from an unset one.
+func ParseUserList(ctx *context.TeaContext, val string) []string {
+	users := []string{}
+	for _, u := range strings.Split(val, ",") {
+		u = strings.TrimSpace(u)
+		if u == "@me" && ctx.Login != nil {
+			u = ctx.Login.User
+		}
+		if len(u) != 0 {
+			users = append(users, u)
+		}
+	}
+	return users
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package flags
+
+import (
+	"slices"
+	"strings"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// AllLoginsFlag selects all logins, see GetLogins
+var AllLoginsFlag = cli.BoolFlag{
+	Name:  "all-logins",
+	Usage: "Run against all logins, adding a login column to the results",
+}
+
+// LoginsListFlag selects several logins by name, see GetLogins
+var LoginsListFlag = cli.StringFlag{
+	Name:  "logins",
+	Usage: "Comma-separated list of logins to run against, adding a login column to the results",
+}
+
+// LoginsFlags select several logins to run a listing against. They are
+// global flags as well, but only supported by commands which add them.
+var LoginsFlags = []cli.Flag{
+	&AllLoginsFlag,
+	&LoginsListFlag,
+}
+
+// LoginFieldsFlag is a FieldsFlag which additionally offers the login field
+// of listings with LoginsFlags
+func LoginFieldsFlag(availableFields, defaultFields []string) *CsvFlag {
+	return FieldsFlag(append(slices.Clip(availableFields), "login"), defaultFields)
+}
+
+// GetLogins returns the logins selected with --all-logins or --logins,
+// or nil if neither is set.
+func GetLogins(ctx *cli.Context) ([]*config.Login, error) {
+	allLogins, names := utils.GlobalLogins()
+	allLogins = allLogins || ctx.Bool(AllLoginsFlag.Name)
+	if n := ctx.String(LoginsListFlag.Name); n != "" {
+		names = n
+	}
+	if !allLogins && names == "" {
+		return nil, nil
+	}
+	if ctx.IsSet("login") {
+		return nil, utils.NewInvalidArgumentErrorf("--login can't be combined with --all-logins or --logins")
+	}
+	if allLogins && names != "" {
+		return nil, utils.NewInvalidArgumentErrorf("--all-logins and --logins can't be combined")
+	}
+
+	all, err := config.GetLogins()
+	if err != nil {
+		return nil, err
+	}
+	var logins []*config.Login
+	if allLogins {
+		for i := range all {
+			logins = append(logins, &all[i])
+		}
+	} else {
+		for _, name := range strings.Split(names, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			login, err := config.GetLoginByName(name)
+			if err != nil {
+				return nil, err
+			}
+			if login == nil {
+				return nil, utils.NewNotExistErrorf("login '%s' does not exist", name)
+			}
+			logins = append(logins, login)
+		}
+	}
+	if len(logins) == 0 {
+		return nil, config.ErrNoLogin
+	}
+	return logins, nil
+}
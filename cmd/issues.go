@@ -5,41 +5,62 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"code.gitea.io/tea/cmd/issues"
+	"code.gitea.io/tea/cmd/search"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
-	"code.gitea.io/tea/modules/utils"
+	"code.gitea.io/tea/modules/task"
 
 	"github.com/urfave/cli/v2"
 )
 
 // CmdIssues represents to login a gitea server.
 var CmdIssues = cli.Command{
-	Name:        "issues",
-	Aliases:     []string{"issue", "i"},
-	Category:    catEntities,
-	Usage:       "List, create and update issues",
-	Description: `Lists issues when called without argument. If issue index is provided, will show it in detail.`,
-	ArgsUsage:   "[<issue index>]",
-	Action:      runIssues,
+	Name:     "issues",
+	Aliases:  []string{"issue", "i"},
+	Category: catEntities,
+	Usage:    "List, create and update issues",
+	Description: `Lists issues when called without argument. If issue index is provided, will show it in detail.
+If @<name> of a search saved with 'tea search save' is provided, runs that search.`,
+	ArgsUsage: "[<issue index> | @<saved search>]",
+	Action:    runIssues,
 	Subcommands: []*cli.Command{
 		&issues.CmdIssuesList,
 		&issues.CmdIssuesCreate,
 		&issues.CmdIssuesEdit,
 		&issues.CmdIssuesReopen,
 		&issues.CmdIssuesClose,
+		&issues.CmdIssuesDeps,
+		&issues.CmdIssuesPin,
+		&issues.CmdIssuesUnpin,
+		&issues.CmdIssuesLock,
+		&issues.CmdIssuesUnlock,
+		&issues.CmdIssuesSubscribe,
+		&issues.CmdIssuesUnsubscribe,
+		&issues.CmdIssuesAttach,
+		&issues.CmdIssuesAttachments,
+		&issues.CmdIssuesTimeline,
 	},
 	Flags: append([]cli.Flag{
 		&cli.BoolFlag{
 			Name:  "comments",
 			Usage: "Whether to display comments (will prompt if not provided & run interactively)",
 		},
-	}, issues.CmdIssuesList.Flags...),
+		&cli.BoolFlag{
+			Name:  "timeline",
+			Usage: "Display the timeline of events instead of the comments",
+		},
+	}, append(issues.TimelineFlags, issues.CmdIssuesList.Flags...)...),
 }
 
 func runIssues(ctx *cli.Context) error {
+	if strings.HasPrefix(ctx.Args().First(), "@") {
+		return search.RunSavedSearch(ctx, ctx.Args().First(), "issues", ctx.Args().Tail())
+	}
 	if ctx.Args().Len() == 1 {
 		return runIssueDetail(ctx, ctx.Args().First())
 	}
@@ -47,28 +68,42 @@ func runIssues(ctx *cli.Context) error {
 }
 
 func runIssueDetail(cmd *cli.Context, index string) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-
-	idx, err := utils.ArgToIndex(index)
+	ctx, err := context.InitCommand(cmd)
 	if err != nil {
 		return err
 	}
-	client := ctx.Login.Client()
-	issue, _, err := client.GetIssue(ctx.Owner, ctx.Repo, idx)
+
+	idx, err := ctx.ArgToIndex(index)
 	if err != nil {
 		return err
 	}
-	reactions, _, err := client.GetIssueReactions(ctx.Owner, ctx.Repo, idx)
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	var timeline *issues.TimelineOptions
+	if ctx.Bool("timeline") {
+		if timeline, err = issues.GetTimelineOptions(ctx); err != nil {
+			return err
+		}
+	}
+
+	issue, extras, err := task.GetIssueWithExtras(ctx.Login, ctx.Owner, ctx.Repo, idx)
 	if err != nil {
 		return err
 	}
-	print.IssueDetails(issue, reactions)
+	reactions, resp, err := ctx.Login.Client().GetIssueReactions(ctx.Owner, ctx.Repo, idx)
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+	print.IssueDetails(issue, reactions, extras)
 
+	if timeline != nil {
+		return issues.PrintTimeline(ctx, idx, timeline)
+	}
 	if issue.Comments > 0 {
 		err = interact.ShowCommentsMaybeInteractive(ctx, idx, issue.Comments)
 		if err != nil {
-			return fmt.Errorf("error loading comments: %v", err)
+			return fmt.Errorf("error loading comments: %w", err)
 		}
 	}
 
@@ -0,0 +1,80 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesAttach represents a sub command of issues to upload attachments
+var CmdIssuesAttach = cli.Command{
+	Name:  "attach",
+	Usage: "Attach files to an issue / pr or comment",
+	Description: `Attach files to an issue / pr, or to one of its comments with --comment:
+
+	tea issues attach 42 crash.log screenshot.png
+	tea issues attach --comment 1337 crash.log`,
+	ArgsUsage: "<issue index> <file> [<file>...]",
+	Action:    runIssuesAttach,
+	Flags: append([]cli.Flag{
+		&cli.Int64Flag{
+			Name:  "comment",
+			Usage: "ID of the comment to attach the files to, instead of the issue / pr itself",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runIssuesAttach(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	target, files, err := attachmentTarget(ctx)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return utils.NewInvalidArgumentErrorf("Must specify at least one file to attach")
+	}
+
+	for _, file := range files {
+		a, err := task.UploadAttachment(ctx.Login, target, file)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		fmt.Println(a.DownloadURL)
+	}
+	return nil
+}
+
+// attachmentTarget returns the issue or comment given via arguments or
+// --comment, and the remaining arguments. It ensures a remote repo is set in ctx.
+func attachmentTarget(ctx *context.TeaContext) (task.AttachmentTarget, []string, error) {
+	target := task.AttachmentTarget{CommentID: ctx.Int64("comment")}
+	args := ctx.Args().Slice()
+	if target.CommentID == 0 {
+		if len(args) == 0 {
+			return target, nil, utils.NewInvalidArgumentErrorf("Must specify an issue / pr index or --comment")
+		}
+		var err error
+		if target.Index, err = ctx.ArgToIndex(args[0]); err != nil {
+			return target, nil, err
+		}
+		args = args[1:]
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return target, nil, err
+	}
+	target.Owner, target.Repo = ctx.Owner, ctx.Repo
+	return target, args, nil
+}
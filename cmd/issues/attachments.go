@@ -0,0 +1,87 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesAttachments represents a sub command of issues to list & download attachments
+var CmdIssuesAttachments = cli.Command{
+	Name:    "attachments",
+	Aliases: []string{"assets"},
+	Usage:   "List or download the attachments of an issue / pr or comment",
+	Description: `List the attachments of an issue / pr, or of one of its comments with --comment.
+With --download, the attachments are saved to --dir instead. Only the attachments named
+after the index are downloaded, if any:
+
+	tea issues attachments --download 42 crash.log`,
+	ArgsUsage: "<issue index> [<name>...]",
+	Action:    runIssuesAttachments,
+	Flags: append([]cli.Flag{
+		&cli.Int64Flag{
+			Name:  "comment",
+			Usage: "ID of the comment to list the attachments of, instead of the issue / pr itself",
+		},
+		&cli.BoolFlag{
+			Name:    "download",
+			Aliases: []string{"d"},
+			Usage:   "Download the attachments",
+		},
+		&cli.StringFlag{
+			Name:  "dir",
+			Usage: "Directory to download the attachments to",
+			Value: ".",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runIssuesAttachments(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	target, names, err := attachmentTarget(ctx)
+	if err != nil {
+		return err
+	}
+	attachments, err := task.ListAttachments(ctx.Login, target)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Bool("download") {
+		print.AttachmentsList(attachments, ctx.Output)
+		return nil
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	downloaded := 0
+	for _, a := range attachments {
+		if len(wanted) != 0 && !wanted[a.Name] {
+			continue
+		}
+		path, err := task.DownloadAttachment(ctx.Login, a, ctx.String("dir"))
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+		downloaded++
+	}
+	if downloaded == 0 && len(wanted) != 0 {
+		return utils.NewNotExistErrorf("no attachment of %s matches the given names", target)
+	}
+	return nil
+}
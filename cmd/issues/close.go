@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/utils"
 
@@ -30,28 +32,43 @@ var CmdIssuesClose = cli.Command{
 
 // editIssueState abstracts the arg parsing to edit the given issue
 func editIssueState(cmd *cli.Context, opts gitea.EditIssueOption) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	if ctx.Args().Len() == 0 {
-		return fmt.Errorf(ctx.Command.ArgsUsage)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
 	}
-
-	indices, err := utils.ArgsToIndices(ctx.Args().Slice())
+	indices, err := ctx.ArgsToIndices(ctx.Args().Slice())
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		// offer the issues whose state would change
+		state := gitea.StateOpen
+		if *opts.State == gitea.StateOpen {
+			state = gitea.StateClosed
+		}
+		idx, err := interact.SelectIssue(ctx, gitea.IssueTypeIssue, state)
+		if err != nil {
+			return err
+		}
+		indices = []int64{idx}
+	}
 
 	client := ctx.Login.Client()
 	for _, index := range indices {
-		issue, _, err := client.EditIssue(ctx.Owner, ctx.Repo, index, opts)
-		if err != nil {
-			return err
+		issue, resp, err := client.EditIssue(ctx.Owner, ctx.Repo, index, opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return config.ClassifyAPIError(resp, err)
 		}
 
 		if len(indices) > 1 {
 			fmt.Println(issue.HTMLURL)
 		} else {
-			print.IssueDetails(issue, nil)
+			print.IssueDetails(issue, nil, nil)
 		}
 	}
 	return nil
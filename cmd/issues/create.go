@@ -14,21 +14,40 @@ import (
 
 // CmdIssuesCreate represents a sub command of issues to create issue
 var CmdIssuesCreate = cli.Command{
-	Name:        "create",
-	Aliases:     []string{"c"},
-	Usage:       "Create an issue on repository",
-	Description: `Create an issue on repository`,
-	ArgsUsage:   " ", // command does not accept arguments
-	Action:      runIssuesCreate,
-	Flags:       flags.IssuePRCreateFlags,
+	Name:    "create",
+	Aliases: []string{"c"},
+	Usage:   "Create an issue on repository",
+	Description: `Create an issue on repository.
+
+Issues can be drafted in a markdown file with YAML front matter, and created via --file:
+
+	---
+	title: Crash on startup
+	labels: [bug]
+	milestone: v1.0
+	assignees: [octocat]
+	deadline: 2026-12-31
+	ref: main
+	---
+	Description of the issue.
+
+A file may hold several issues, each starting with its own front matter block.`,
+	ArgsUsage: " ", // command does not accept arguments
+	Action:    runIssuesCreate,
+	Flags:     flags.IssuePRCreateFlags,
 }
 
 func runIssuesCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
-	if ctx.NumFlags() == 0 {
-		return interact.CreateIssue(ctx.Login, ctx.Owner, ctx.Repo)
+	if ctx.NumFlags() == 0 || (ctx.NumFlags() == 1 && ctx.IsSet("template")) {
+		return interact.CreateIssue(ctx)
 	}
 
 	opts, err := flags.GetIssuePRCreateFlags(ctx)
@@ -36,6 +55,28 @@ func runIssuesCreate(cmd *cli.Context) error {
 		return err
 	}
 
+	if name := ctx.String("template"); len(name) != 0 {
+		templates, err := task.FindIssueTemplates(ctx)
+		if err != nil {
+			return err
+		}
+		tmpl, err := task.GetTemplate(templates, name)
+		if err != nil {
+			return err
+		}
+		if err = task.ApplyTemplate(ctx, tmpl, opts); err != nil {
+			return err
+		}
+	}
+
+	if file := ctx.String("file"); len(file) != 0 {
+		drafts, err := task.ReadIssueDrafts(file, ctx.App.Reader)
+		if err != nil {
+			return err
+		}
+		return task.CreateIssuesFromDrafts(ctx, drafts, *opts)
+	}
+
 	return task.CreateIssue(
 		ctx.Login,
 		ctx.Owner,
@@ -0,0 +1,161 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesDeps is the subcommand of issues to manage issue dependencies
+var CmdIssuesDeps = cli.Command{
+	Name:    "deps",
+	Aliases: []string{"dependencies"},
+	Usage:   "Manage dependencies between issues",
+	Description: `Manage which issues block or are blocked by other issues.
+Dependencies may reference issues of other repos, as owner/repo#index or URL.`,
+	ArgsUsage: "<idx>",
+	Action:    runIssueDepsList,
+	Subcommands: []*cli.Command{
+		&CmdIssuesDepsList,
+		&CmdIssuesDepsAdd,
+		&CmdIssuesDepsRemove,
+		&CmdIssuesDepsGraph,
+	},
+	Flags: flags.AllDefaultFlags,
+}
+
+// CmdIssuesDepsList lists the dependencies of an issue
+var CmdIssuesDepsList = cli.Command{
+	Name:        "list",
+	Aliases:     []string{"ls"},
+	Usage:       "List the dependencies of an issue",
+	Description: "List the issues blocking an issue, and the issues blocked by it",
+	ArgsUsage:   "<idx>",
+	Action:      runIssueDepsList,
+	Flags:       flags.AllDefaultFlags,
+}
+
+var depsEditFlags = append([]cli.Flag{
+	&cli.StringSliceFlag{
+		Name:  "blocks",
+		Usage: "Reference to an issue blocked by the issue, as index, owner/repo#index or URL. May be repeated",
+	},
+	&cli.StringSliceFlag{
+		Name:  "blocked-by",
+		Usage: "Reference to an issue blocking the issue, as index, owner/repo#index or URL. May be repeated",
+	},
+}, flags.LoginRepoFlags...)
+
+// CmdIssuesDepsAdd adds dependencies to an issue
+var CmdIssuesDepsAdd = cli.Command{
+	Name:        "add",
+	Aliases:     []string{"a"},
+	Usage:       "Add dependencies to an issue",
+	Description: "Add dependencies to an issue, eg. tea issues deps add 12 --blocked-by 10 --blocks gitea/tea#3",
+	ArgsUsage:   "<idx>",
+	Action: func(cmd *cli.Context) error {
+		return runIssueDepsEdit(cmd, task.AddIssueDependency)
+	},
+	Flags: depsEditFlags,
+}
+
+// CmdIssuesDepsRemove removes dependencies from an issue
+var CmdIssuesDepsRemove = cli.Command{
+	Name:        "remove",
+	Aliases:     []string{"rm"},
+	Usage:       "Remove dependencies from an issue",
+	Description: "Remove dependencies from an issue, eg. tea issues deps remove 12 --blocked-by 10",
+	ArgsUsage:   "<idx>",
+	Action: func(cmd *cli.Context) error {
+		return runIssueDepsEdit(cmd, task.RemoveIssueDependency)
+	},
+	Flags: depsEditFlags,
+}
+
+// depsIssueIndex returns the index of the issue given as the only argument,
+// or lets the user select one. It ensures a remote repo is set in ctx.
+func depsIssueIndex(ctx *context.TeaContext) (int64, error) {
+	var idx int64
+	switch ctx.Args().Len() {
+	case 0:
+	case 1:
+		var err error
+		if idx, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, utils.NewInvalidArgumentErrorf("Must specify a single issue index")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return 0, err
+	}
+	if ctx.Args().Len() == 0 {
+		return interact.SelectIssue(ctx, gitea.IssueTypeAll, gitea.StateOpen)
+	}
+	return idx, nil
+}
+
+func runIssueDepsList(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	idx, err := depsIssueIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockedBy, blocks, err := task.ListIssueDependencies(ctx.Login, ctx.Owner, ctx.Repo, idx)
+	if err != nil {
+		return err
+	}
+	print.IssueDependencies(blockedBy, blocks, ctx.Owner+"/"+ctx.Repo, ctx.Output)
+	return nil
+}
+
+func runIssueDepsEdit(cmd *cli.Context, edit func(*config.Login, string, string, int64, task.DependencyRelation, utils.IndexRef) error) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	refs := map[task.DependencyRelation][]string{
+		task.DependencyBlocks:    ctx.StringSlice("blocks"),
+		task.DependencyBlockedBy: ctx.StringSlice("blocked-by"),
+	}
+	if len(refs[task.DependencyBlocks]) == 0 && len(refs[task.DependencyBlockedBy]) == 0 {
+		return utils.NewInvalidArgumentErrorf("specify at least one dependency with --blocks or --blocked-by")
+	}
+	idx, err := depsIssueIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, relation := range []task.DependencyRelation{task.DependencyBlocks, task.DependencyBlockedBy} {
+		for _, arg := range refs[relation] {
+			ref, err := utils.ParseIndexRef(arg)
+			if err != nil {
+				return err
+			}
+			err = edit(ctx.Login, ctx.Owner, ctx.Repo, idx, relation, ref)
+			if utils.IsDryRun(err) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("#%d %s %s: %w", idx, relation, arg, err)
+			}
+		}
+	}
+	return nil
+}
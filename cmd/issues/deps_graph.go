@@ -0,0 +1,80 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesDepsGraph renders the dependencies between the issues of a milestone
+var CmdIssuesDepsGraph = cli.Command{
+	Name:    "graph",
+	Aliases: []string{"g"},
+	Usage:   "Show the dependency tree of a milestone",
+	Description: `Show the dependencies between the issues & pulls of a milestone.
+Issues outside of the milestone that are blocked by one of its issues are included.
+
+As text, each issue is followed by the issues it blocks. Graphviz DOT can be rendered eg. with:
+
+	tea issues deps graph --format dot 1.2 | dot -Tsvg > deps.svg`,
+	ArgsUsage: "<milestone name>",
+	Action:    runIssueDepsGraph,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format (text|dot)",
+			Value: "text",
+		},
+	}, flags.LoginRepoFlags...),
+}
+
+func runIssueDepsGraph(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+
+	format := ctx.String("format")
+	if format != "text" && format != "dot" {
+		return utils.NewInvalidArgumentErrorf("unknown format '%s', expected text or dot", format)
+	}
+
+	var milestone string
+	switch ctx.Args().Len() {
+	case 0:
+		if milestone, err = interact.SelectMilestone(ctx, gitea.StateOpen); err != nil {
+			return err
+		}
+	case 1:
+		milestone = ctx.Args().First()
+	default:
+		return utils.NewInvalidArgumentErrorf("Must specify a single milestone name")
+	}
+	if _, err := task.GetMilestoneByName(ctx.Login.Client(), ctx.Owner, ctx.Repo, milestone); err != nil {
+		return err
+	}
+
+	issues, blocks, err := task.IssueDependencyGraph(ctx.Login, ctx.Owner, ctx.Repo, milestone)
+	if err != nil {
+		return err
+	}
+	baseRepo := ctx.Owner + "/" + ctx.Repo
+	if format == "dot" {
+		print.IssueDependencyDot(issues, blocks, baseRepo)
+	} else {
+		print.IssueDependencyTree(issues, blocks, baseRepo)
+	}
+	return nil
+}
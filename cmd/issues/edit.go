@@ -5,13 +5,16 @@ package issues
 
 import (
 	"fmt"
+	"os"
 
 	"code.gitea.io/tea/cmd/flags"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/task"
 	"code.gitea.io/tea/modules/utils"
 
+	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
@@ -21,18 +24,37 @@ var CmdIssuesEdit = cli.Command{
 	Aliases: []string{"e"},
 	Usage:   "Edit one or more issues",
 	Description: `Edit one or more issues. To unset a property again,
-use an empty string (eg. --milestone "").`,
+use an empty string (eg. --milestone "").
+
+Assignees can be added, removed or replaced; '@me' refers to the login user:
+
+	tea issues edit --set-assignees @me,alice 42
+
+Instead of indices, the issues to edit can be selected with --where-* filters,
+which work like the filters of 'tea issues list'. The matching issues are
+previewed, and edited after confirmation:
+
+	tea issues edit --where-labels bug --where-milestone 1.2 --add-labels triaged --milestone 1.3
+
+With a machine readable --output, the edited issues are printed to stdout,
+while the preview and progress go to stderr.`,
 	ArgsUsage: "<idx> [<idx>...]",
 	Action:    runIssuesEdit,
-	Flags:     flags.IssuePREditFlags,
+	Flags:     append(append([]cli.Flag{&flags.OutputFlag}, flags.IssueWhereFlags...), flags.IssuePREditFlags...),
 }
 
 func runIssuesEdit(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
-	if !cmd.Args().Present() {
-		return fmt.Errorf("must specify at least one issue index")
+	indices, err := ctx.ArgsToIndices(ctx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
 	}
 
 	opts, err := flags.GetIssuePREditFlags(ctx)
@@ -40,23 +62,100 @@ func runIssuesEdit(cmd *cli.Context) error {
 		return err
 	}
 
-	indices, err := utils.ArgsToIndices(ctx.Args().Slice())
+	where, err := flags.GetIssueWhereOptions(ctx)
 	if err != nil {
 		return err
 	}
+	if where != nil {
+		if ctx.Args().Present() {
+			return utils.NewInvalidArgumentErrorf("issue indices can't be combined with --where-* filters")
+		}
+		return editIssuesWhere(ctx, *where, *opts)
+	}
+
+	if len(indices) == 0 {
+		idx, err := interact.SelectIssue(ctx, gitea.IssueTypeIssue, gitea.StateOpen)
+		if err != nil {
+			return err
+		}
+		indices = []int64{idx}
+	}
 
 	client := ctx.Login.Client()
 	for _, opts.Index = range indices {
 		issue, err := task.EditIssue(ctx, client, *opts)
-		if err != nil {
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
 			return err
 		}
 		if ctx.Args().Len() > 1 {
 			fmt.Println(issue.HTMLURL)
 		} else {
-			print.IssueDetails(issue, nil)
+			print.IssueDetails(issue, nil, nil)
 		}
 	}
 
 	return nil
 }
+
+// editIssuesWhere applies opts to all issues matching the filter where,
+// after previewing them and asking for confirmation.
+func editIssuesWhere(ctx *context.TeaContext, where gitea.ListIssueOption, opts task.EditIssueOption) error {
+	client := ctx.Login.Client()
+
+	issues, err := task.ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Issue, *gitea.Response, error) {
+		where.ListOptions = opt
+		return client.ListRepoIssues(ctx.Owner, ctx.Repo, where)
+	})
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		fmt.Fprintln(os.Stderr, "No issues match the given filters")
+		return nil
+	}
+
+	// the preview and summary go to stderr, so that stdout only holds the
+	// edited issues in machine readable output
+	print.FprintIssuesPullsList(os.Stderr, issues, "", []string{"index", "title", "state", "labels", "milestone"})
+	confirmed, err := interact.Confirm(fmt.Sprintf("Edit these %d issues?", len(issues)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return utils.NewAbortedErrorf("aborted, no issues were edited")
+	}
+
+	indices := make([]int64, len(issues))
+	for i, issue := range issues {
+		indices[i] = issue.Index
+	}
+	results, err := task.EditIssues(ctx, client, opts, indices, func(done, total int) {
+		print.Progress("Editing issues", done, total)
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	var edited []*gitea.Issue
+	for _, r := range results {
+		if r.Err != nil && !utils.IsDryRun(r.Err) {
+			failed++
+			fmt.Fprintf(os.Stderr, "#%d: %v\n", r.Index, r.Err)
+		} else if r.Issue != nil {
+			edited = append(edited, r.Issue)
+		}
+	}
+	if print.IsMachineReadable(ctx.Output) {
+		print.IssuesPullsList(edited, ctx.Output, []string{"index", "title", "state", "labels", "milestone"})
+	}
+	if failed != 0 {
+		return fmt.Errorf("editing %d of %d issues failed", failed, len(results))
+	}
+	if !utils.DryRun() {
+		fmt.Fprintf(os.Stderr, "Edited %d issues\n", len(results))
+	}
+	return nil
+}
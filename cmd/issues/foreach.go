@@ -0,0 +1,48 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// RunForEachIssue calls action for each issue or pull given as argument.
+// Without arguments, the user selects one of kind in the given state.
+func RunForEachIssue(cmd *cli.Context, kind gitea.IssueType, state gitea.StateType, action func(ctx *context.TeaContext, index int64) error) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	indices, err := ctx.ArgsToIndices(ctx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		idx, err := interact.SelectIssue(ctx, kind, state)
+		if err != nil {
+			return err
+		}
+		indices = []int64{idx}
+	}
+
+	for _, index := range indices {
+		err := action(ctx, index)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("#%d: %w", index, err)
+		}
+	}
+	return nil
+}
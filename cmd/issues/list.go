@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/araddon/dateparse"
@@ -33,18 +35,14 @@ var CmdIssuesList = cli.Command{
 
 // RunIssuesList list issues
 func RunIssuesList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
-	state := gitea.StateOpen
-	switch ctx.String("state") {
-	case "all":
-		state = gitea.StateAll
-	case "", "open":
-		state = gitea.StateOpen
-	case "closed":
-		state = gitea.StateClosed
-	default:
-		return fmt.Errorf("unknown state '%s'", ctx.String("state"))
+	state, err := flags.ParseState(ctx.String("state"))
+	if err != nil {
+		return err
 	}
 
 	kind := gitea.IssueTypeIssue
@@ -59,7 +57,6 @@ func RunIssuesList(cmd *cli.Context) error {
 		return fmt.Errorf("unknown kind '%s'", ctx.String("kind"))
 	}
 
-	var err error
 	var from, until time.Time
 	if ctx.IsSet("from") {
 		from, err = dateparse.ParseLocal(ctx.String("from"))
@@ -82,8 +79,9 @@ func RunIssuesList(cmd *cli.Context) error {
 	labels, _ := flags.LabelFilterFlag.GetValues(cmd)
 	milestones, _ := flags.MilestoneFilterFlag.GetValues(cmd)
 	var issues []*gitea.Issue
+	var resp *gitea.Response
 	if ctx.Repo != "" {
-		issues, _, err = ctx.Login.Client().ListRepoIssues(owner, ctx.Repo, gitea.ListIssueOption{
+		issues, resp, err = ctx.Login.Client().ListRepoIssues(owner, ctx.Repo, gitea.ListIssueOption{
 			ListOptions: ctx.GetListOptions(),
 			State:       state,
 			Type:        kind,
@@ -98,10 +96,10 @@ func RunIssuesList(cmd *cli.Context) error {
 		})
 
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 	} else {
-		issues, _, err = ctx.Login.Client().ListIssues(gitea.ListIssueOption{
+		issues, resp, err = ctx.Login.Client().ListIssues(gitea.ListIssueOption{
 			ListOptions: ctx.GetListOptions(),
 			State:       state,
 			Type:        kind,
@@ -117,7 +115,7 @@ func RunIssuesList(cmd *cli.Context) error {
 		})
 
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 	}
 
@@ -126,6 +124,10 @@ func RunIssuesList(cmd *cli.Context) error {
 		return err
 	}
 
-	print.IssuesPullsList(issues, ctx.Output, fields)
+	extras, err := task.ListIssueExtras(ctx.Login, issues, fields)
+	if err != nil {
+		return err
+	}
+	print.IssuesPullsListWithExtras(issues, extras, ctx.Output, fields)
 	return nil
 }
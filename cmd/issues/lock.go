@@ -0,0 +1,57 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// LockFlags are the flags of the lock commands for issues and pulls
+var LockFlags = append([]cli.Flag{
+	&cli.StringFlag{
+		Name:  "reason",
+		Usage: "Reason for locking, as configured on the server (by default 'Too heated', 'Off-topic', 'Resolved' or 'Spam')",
+	},
+}, flags.LoginRepoFlags...)
+
+// CmdIssuesLock represents a sub command of issues to lock conversations
+var CmdIssuesLock = cli.Command{
+	Name:  "lock",
+	Usage: "Lock the conversation of one or more issues",
+	Description: `Lock the conversation of one or more issues, so only collaborators can comment:
+
+	tea issues lock --reason "Too heated" 42`,
+	ArgsUsage: "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateAll, LockAction(true))
+	},
+	Flags: LockFlags,
+}
+
+// CmdIssuesUnlock represents a sub command of issues to unlock conversations
+var CmdIssuesUnlock = cli.Command{
+	Name:        "unlock",
+	Usage:       "Unlock the conversation of one or more issues",
+	Description: `Unlock the conversation of one or more issues`,
+	ArgsUsage:   "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateAll, LockAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// LockAction returns an action for RunForEachIssue to lock or unlock issues
+func LockAction(lock bool) func(*context.TeaContext, int64) error {
+	return func(ctx *context.TeaContext, index int64) error {
+		if !lock {
+			return task.UnlockIssue(ctx.Login, ctx.Owner, ctx.Repo, index)
+		}
+		return task.LockIssue(ctx.Login, ctx.Owner, ctx.Repo, index, ctx.String("reason"))
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesPin represents a sub command of issues to pin issues
+var CmdIssuesPin = cli.Command{
+	Name:        "pin",
+	Usage:       "Pin one or more issues to the top of the issue list",
+	Description: `Pin one or more issues to the top of the issue list`,
+	ArgsUsage:   "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateOpen, PinAction(true))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// CmdIssuesUnpin represents a sub command of issues to unpin issues
+var CmdIssuesUnpin = cli.Command{
+	Name:        "unpin",
+	Usage:       "Unpin one or more issues",
+	Description: `Unpin one or more issues`,
+	ArgsUsage:   "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateAll, PinAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// PinAction returns an action for RunForEachIssue to pin or unpin issues
+func PinAction(pin bool) func(*context.TeaContext, int64) error {
+	return func(ctx *context.TeaContext, index int64) error {
+		return task.PinIssue(ctx.Login, ctx.Owner, ctx.Repo, index, pin)
+	}
+}
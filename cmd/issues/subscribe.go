@@ -0,0 +1,47 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdIssuesSubscribe represents a sub command of issues to watch issues
+var CmdIssuesSubscribe = cli.Command{
+	Name:        "subscribe",
+	Aliases:     []string{"watch"},
+	Usage:       "Subscribe to notifications of one or more issues",
+	Description: `Subscribe to notifications of one or more issues`,
+	ArgsUsage:   "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateOpen, SubscribeAction(true))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// CmdIssuesUnsubscribe represents a sub command of issues to unwatch issues
+var CmdIssuesUnsubscribe = cli.Command{
+	Name:        "unsubscribe",
+	Aliases:     []string{"unwatch"},
+	Usage:       "Unsubscribe from notifications of one or more issues",
+	Description: `Unsubscribe from notifications of one or more issues`,
+	ArgsUsage:   "<issue index> [<issue index>...]",
+	Action: func(cmd *cli.Context) error {
+		return RunForEachIssue(cmd, gitea.IssueTypeIssue, gitea.StateAll, SubscribeAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// SubscribeAction returns an action for RunForEachIssue to subscribe to or
+// unsubscribe from issues
+func SubscribeAction(subscribe bool) func(*context.TeaContext, int64) error {
+	return func(ctx *context.TeaContext, index int64) error {
+		return task.SubscribeIssue(ctx.Login, ctx.Owner, ctx.Repo, index, subscribe)
+	}
+}
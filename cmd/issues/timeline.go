@@ -0,0 +1,126 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"strings"
+	"time"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/araddon/dateparse"
+	"github.com/urfave/cli/v2"
+)
+
+var timelineFieldsFlag = flags.FieldsFlag(print.TimelineFields, []string{
+	"time", "actor", "event", "details",
+})
+
+var timelineTypeFlag = flags.NewCsvFlag("type", "event types to show", []string{"t"}, task.TimelineCategories, nil)
+
+// CmdIssuesTimeline is the subcommand of issues to show the timeline of an issue
+var CmdIssuesTimeline = cli.Command{
+	Name:    "timeline",
+	Aliases: []string{"events"},
+	Usage:   "Show the timeline of an issue / pr",
+	Description: `Show the events of an issue / pr in chronological order: comments,
+state, label, milestone and assignee changes, references, reviews and more.
+Events can be filtered by type, eg. to show how the labels of an issue changed:
+
+	tea issues timeline --type label,assignee 42`,
+	ArgsUsage: "<idx>",
+	Action:    runIssuesTimeline,
+	Flags:     append(append([]cli.Flag{timelineFieldsFlag}, TimelineFlags...), flags.AllDefaultFlags...),
+}
+
+// TimelineFlags select the events of a timeline. Besides CmdIssuesTimeline,
+// they are used by the detail views of issues and pulls with --timeline.
+var TimelineFlags = []cli.Flag{
+	timelineTypeFlag,
+	&cli.StringFlag{
+		Name:    "since",
+		Aliases: []string{"s"},
+		Usage:   "Only show events after the given time",
+	},
+}
+
+// TimelineOptions select and format the events of a timeline
+type TimelineOptions struct {
+	Since  time.Time
+	Types  []string
+	Fields []string
+}
+
+// GetTimelineOptions parses the TimelineFlags and --fields. In detail views,
+// --fields defaults to the fields of the listing, so the default timeline
+// fields are used unless it's set.
+func GetTimelineOptions(ctx *context.TeaContext) (*TimelineOptions, error) {
+	opts := &TimelineOptions{Fields: strings.Split(timelineFieldsFlag.Value, ",")}
+	var err error
+	if ctx.IsSet("since") {
+		if opts.Since, err = dateparse.ParseLocal(ctx.String("since")); err != nil {
+			return nil, utils.NewInvalidArgumentErrorf("invalid --since: %v", err)
+		}
+	}
+	if ctx.String("type") != "" {
+		if opts.Types, err = timelineTypeFlag.GetValues(ctx.Context); err != nil {
+			return nil, utils.NewInvalidArgumentErrorf("invalid --type: %v", err)
+		}
+	}
+	if ctx.IsSet("fields") {
+		if opts.Fields, err = timelineFieldsFlag.GetValues(ctx.Context); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// PrintTimeline prints the timeline of the issue / pr idx in the repo of ctx
+func PrintTimeline(ctx *context.TeaContext, idx int64, opts *TimelineOptions) error {
+	events, err := task.ListIssueTimeline(ctx.Login, ctx.Owner, ctx.Repo, idx, opts.Since)
+	if err != nil {
+		return err
+	}
+	print.TimelineList(task.FilterTimeline(events, opts.Types), ctx.Owner+"/"+ctx.Repo, ctx.Output, opts.Fields)
+	return nil
+}
+
+func runIssuesTimeline(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, err := GetTimelineOptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var idx int64
+	switch ctx.Args().Len() {
+	case 0:
+	case 1:
+		if idx, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+			return err
+		}
+	default:
+		return utils.NewInvalidArgumentErrorf("Must specify a single issue / pr index")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	if ctx.Args().Len() == 0 {
+		if idx, err = interact.SelectIssue(ctx, gitea.IssueTypeAll, gitea.StateAll); err != nil {
+			return err
+		}
+	}
+
+	return PrintTimeline(ctx, idx, opts)
+}
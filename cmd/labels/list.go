@@ -5,6 +5,7 @@ package labels
 
 import (
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/task"
@@ -34,15 +35,20 @@ var CmdLabelsList = cli.Command{
 
 // RunLabelsList list labels.
 func RunLabelsList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	client := ctx.Login.Client()
-	labels, _, err := client.ListRepoLabels(ctx.Owner, ctx.Repo, gitea.ListLabelsOptions{
+	labels, resp, err := client.ListRepoLabels(ctx.Owner, ctx.Repo, gitea.ListLabelsOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	if ctx.IsSet("save") {
@@ -5,6 +5,7 @@ package labels
 
 import (
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 
 	"code.gitea.io/sdk/gitea"
@@ -39,8 +40,13 @@ var CmdLabelUpdate = cli.Command{
 }
 
 func runLabelUpdate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	id := ctx.Int64("id")
 	var pName, pColor, pDescription *string
@@ -59,15 +65,14 @@ func runLabelUpdate(cmd *cli.Context) error {
 		pDescription = &description
 	}
 
-	var err error
-	_, _, err = ctx.Login.Client().EditLabel(ctx.Owner, ctx.Repo, id, gitea.EditLabelOption{
+	_, resp, err := ctx.Login.Client().EditLabel(ctx.Owner, ctx.Repo, id, gitea.EditLabelOption{
 		Name:        pName,
 		Color:       pColor,
 		Description: pDescription,
 	})
 
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	return nil
@@ -5,7 +5,6 @@ package login
 
 import (
 	"errors"
-	"log"
 
 	"code.gitea.io/tea/modules/config"
 
@@ -26,7 +25,7 @@ var CmdLoginDelete = cli.Command{
 func RunLoginDelete(ctx *cli.Context) error {
 	logins, err := config.GetLogins()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	var name string
@@ -23,5 +23,9 @@ var CmdLoginEdit = cli.Command{
 }
 
 func runLoginEdit(_ *cli.Context) error {
-	return open.Start(config.GetConfigPath())
+	path, err := config.GetConfigPath()
+	if err != nil {
+		return err
+	}
+	return open.Start(path)
 }
@@ -6,13 +6,13 @@ package login
 import (
 	"bufio"
 	"fmt"
-	"log"
 	"net/url"
 	"os"
 	"strings"
 
 	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
 	"github.com/urfave/cli/v2"
 )
 
@@ -85,14 +85,17 @@ var CmdLoginHelper = cli.Command{
 				}
 
 				if len(wants["host"]) == 0 {
-					log.Fatal("Require hostname")
+					return utils.NewInvalidArgumentErrorf("Require hostname")
 				} else if len(wants["protocol"]) == 0 {
 					wants["protocol"] = "http"
 				}
 
-				userConfig := config.GetLoginByHost(wants["host"])
-				if len(userConfig.Token) == 0 {
-					log.Fatal("User no set")
+				userConfig, err := config.GetLoginByHost(wants["host"])
+				if err != nil {
+					return err
+				}
+				if userConfig == nil || len(userConfig.Token) == 0 {
+					return utils.NewNotExistErrorf("User no set")
 				}
 
 				host, err := url.Parse(userConfig.URL)
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"code.gitea.io/tea/cmd/milestones"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -39,13 +40,18 @@ func runMilestones(ctx *cli.Context) error {
 }
 
 func runMilestoneDetail(cmd *cli.Context, name string) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
-	milestone, _, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, name)
+	milestone, resp, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, name)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.MilestoneDetails(milestone)
@@ -49,7 +49,10 @@ var CmdMilestonesCreate = cli.Command{
 }
 
 func runMilestonesCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	date := ctx.String("deadline")
 	deadline := &time.Time{}
@@ -7,9 +7,10 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
-	"code.gitea.io/tea/modules/utils"
+	"code.gitea.io/tea/modules/task"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -70,8 +71,13 @@ var CmdMilestoneRemoveIssue = cli.Command{
 }
 
 func runMilestoneIssueList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	state := gitea.StateOpen
@@ -96,74 +102,88 @@ func runMilestoneIssueList(cmd *cli.Context) error {
 
 	milestone := ctx.Args().First()
 	// make sure milestone exist
-	_, _, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, milestone)
+	_, resp, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, milestone)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	issues, _, err := client.ListRepoIssues(ctx.Owner, ctx.Repo, gitea.ListIssueOption{
+	issues, resp, err := client.ListRepoIssues(ctx.Owner, ctx.Repo, gitea.ListIssueOption{
 		ListOptions: ctx.GetListOptions(),
 		Milestones:  []string{milestone},
 		Type:        kind,
 		State:       state,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	fields, err := msIssuesFieldsFlag.GetValues(cmd)
 	if err != nil {
 		return err
 	}
-	print.IssuesPullsList(issues, ctx.Output, fields)
+	extras, err := task.ListIssueExtras(ctx.Login, issues, fields)
+	if err != nil {
+		return err
+	}
+	print.IssuesPullsListWithExtras(issues, extras, ctx.Output, fields)
 	return nil
 }
 
 func runMilestoneIssueAdd(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() != 2 {
 		return fmt.Errorf("need two arguments")
 	}
 
 	mileName := ctx.Args().Get(0)
 	issueIndex := ctx.Args().Get(1)
-	idx, err := utils.ArgToIndex(issueIndex)
+	idx, err := ctx.ArgToIndex(issueIndex)
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
 
 	// make sure milestone exist
-	mile, _, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, mileName)
+	mile, resp, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, mileName)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	_, _, err = client.EditIssue(ctx.Owner, ctx.Repo, idx, gitea.EditIssueOption{
+	_, resp, err = client.EditIssue(ctx.Owner, ctx.Repo, idx, gitea.EditIssueOption{
 		Milestone: &mile.ID,
 	})
-	return err
+	return config.ClassifyAPIError(resp, err)
 }
 
 func runMilestoneIssueRemove(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() != 2 {
 		return fmt.Errorf("need two arguments")
 	}
 
 	mileName := ctx.Args().Get(0)
 	issueIndex := ctx.Args().Get(1)
-	idx, err := utils.ArgToIndex(issueIndex)
+	idx, err := ctx.ArgToIndex(issueIndex)
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
 
-	issue, _, err := client.GetIssue(ctx.Owner, ctx.Repo, idx)
+	issue, resp, err := client.GetIssue(ctx.Owner, ctx.Repo, idx)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	if issue.Milestone == nil {
@@ -175,8 +195,8 @@ func runMilestoneIssueRemove(cmd *cli.Context) error {
 	}
 
 	zero := int64(0)
-	_, _, err = client.EditIssue(ctx.Owner, ctx.Repo, idx, gitea.EditIssueOption{
+	_, resp, err = client.EditIssue(ctx.Owner, ctx.Repo, idx, gitea.EditIssueOption{
 		Milestone: &zero,
 	})
-	return err
+	return config.ClassifyAPIError(resp, err)
 }
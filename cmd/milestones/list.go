@@ -5,6 +5,7 @@ package milestones
 
 import (
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -38,8 +39,13 @@ var CmdMilestonesList = cli.Command{
 
 // RunMilestonesList list milestones
 func RunMilestonesList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	fields, err := fieldsFlag.GetValues(cmd)
 	if err != nil {
@@ -58,13 +64,13 @@ func RunMilestonesList(cmd *cli.Context) error {
 	}
 
 	client := ctx.Login.Client()
-	milestones, _, err := client.ListRepoMilestones(ctx.Owner, ctx.Repo, gitea.ListMilestoneOption{
+	milestones, resp, err := client.ListRepoMilestones(ctx.Owner, ctx.Repo, gitea.ListMilestoneOption{
 		ListOptions: ctx.GetListOptions(),
 		State:       state,
 	})
 
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.MilestonesList(milestones, ctx.Output, fields)
@@ -7,8 +7,11 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -28,29 +31,46 @@ var CmdMilestonesReopen = cli.Command{
 }
 
 func editMilestoneStatus(cmd *cli.Context, close bool) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	if ctx.Args().Len() == 0 {
-		return fmt.Errorf(ctx.Command.ArgsUsage)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
 	}
-
 	state := gitea.StateOpen
 	if close {
 		state = gitea.StateClosed
 	}
 
+	names := ctx.Args().Slice()
+	if len(names) == 0 {
+		// offer the milestones whose state would change
+		selectState := gitea.StateClosed
+		if close {
+			selectState = gitea.StateOpen
+		}
+		name, err := interact.SelectMilestone(ctx, selectState)
+		if err != nil {
+			return err
+		}
+		names = []string{name}
+	}
+
 	client := ctx.Login.Client()
-	for _, ms := range ctx.Args().Slice() {
+	for _, ms := range names {
 		opts := gitea.EditMilestoneOption{
 			State: &state,
 			Title: ms,
 		}
-		milestone, _, err := client.EditMilestoneByName(ctx.Owner, ctx.Repo, ms, opts)
-		if err != nil {
-			return err
+		milestone, resp, err := client.EditMilestoneByName(ctx.Owner, ctx.Repo, ms, opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return config.ClassifyAPIError(resp, err)
 		}
 
-		if ctx.Args().Len() > 1 {
+		if len(names) > 1 {
 			fmt.Printf("%s/milestone/%d\n", ctx.GetRemoteRepoHTMLURL(), milestone.ID)
 		} else {
 			print.MilestoneDetails(milestone)
@@ -4,17 +4,20 @@
 package notifications
 
 import (
-	"log"
+	"slices"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
-var notifyFieldsFlag = flags.FieldsFlag(print.NotificationFields, []string{
+var notifyFieldsFlag = flags.LoginFieldsFlag(print.NotificationFields, []string{
 	"id", "status", "index", "type", "state", "title",
 })
 
@@ -29,10 +32,10 @@ var CmdNotificationsList = cli.Command{
 	Description: `List notifications`,
 	ArgsUsage:   " ", // command does not accept arguments
 	Action:      RunNotificationsList,
-	Flags: append([]cli.Flag{
+	Flags: append(append([]cli.Flag{
 		notifyFieldsFlag,
 		notifyTypeFlag,
-	}, flags.NotificationFlags...),
+	}, flags.LoginsFlags...), flags.NotificationFlags...),
 }
 
 // RunNotificationsList list notifications
@@ -60,11 +63,10 @@ func RunNotificationsList(ctx *cli.Context) error {
 
 // listNotifications will get the notifications based on status and subject type
 func listNotifications(cmd *cli.Context, status []gitea.NotifyStatus, subjects []gitea.NotifySubjectType) error {
-	var news []*gitea.NotificationThread
-	var err error
-
-	ctx := context.InitCommand(cmd)
-	client := ctx.Login.Client()
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	all := ctx.Bool("mine")
 
 	// This enforces pagination (see https://github.com/go-gitea/gitea/issues/16733)
@@ -78,29 +80,49 @@ func listNotifications(cmd *cli.Context, status []gitea.NotifyStatus, subjects [
 		return err
 	}
 
+	logins, err := flags.GetLogins(cmd)
+	if err != nil {
+		return err
+	}
 	if all {
 		// add repository to the default fields
 		if !cmd.IsSet("fields") {
 			fields = append(fields, "repository")
 		}
+	} else if logins != nil {
+		return utils.NewInvalidArgumentErrorf("--all-logins and --logins require --mine, as repositories differ between logins")
+	} else if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
-		news, _, err = client.ListNotifications(gitea.ListNotificationOptions{
-			ListOptions:  listOpts,
-			Status:       status,
-			SubjectTypes: subjects,
-		})
-	} else {
-		ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-		news, _, err = client.ListRepoNotifications(ctx.Owner, ctx.Repo, gitea.ListNotificationOptions{
+	list := func(login *config.Login) ([]*gitea.NotificationThread, error) {
+		opts := gitea.ListNotificationOptions{
 			ListOptions:  listOpts,
 			Status:       status,
 			SubjectTypes: subjects,
-		})
+		}
+		var news []*gitea.NotificationThread
+		var resp *gitea.Response
+		var err error
+		if all {
+			news, resp, err = login.Client().ListNotifications(opts)
+		} else {
+			news, resp, err = login.Client().ListRepoNotifications(ctx.Owner, ctx.Repo, opts)
+		}
+		return news, config.ClassifyAPIError(resp, err)
 	}
-	if err != nil {
-		log.Fatal(err)
+
+	if logins == nil && slices.Contains(fields, "login") {
+		logins = []*config.Login{ctx.Login}
+	}
+	if logins != nil {
+		return print.NotificationsListPerLogin(task.ForEachLogin(logins, list), ctx.Output, fields)
 	}
 
+	news, err := list(ctx.Login)
+	if err != nil {
+		return err
+	}
 	print.NotificationsList(news, ctx.Output, fields)
 	return nil
 }
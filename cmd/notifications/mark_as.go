@@ -8,7 +8,9 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
 	"code.gitea.io/tea/modules/utils"
 	"github.com/urfave/cli/v2"
 )
@@ -19,10 +21,13 @@ var CmdNotificationsMarkRead = cli.Command{
 	Aliases:     []string{"r"},
 	Usage:       "Mark all filtered or a specific notification as read",
 	Description: "Mark all filtered or a specific notification as read",
-	ArgsUsage:   "[all | <notification id>]",
+	ArgsUsage:   "[all | <notification id> | <issue or pull reference>]",
 	Flags:       flags.NotificationFlags,
 	Action: func(ctx *cli.Context) error {
-		cmd := context.InitCommand(ctx)
+		cmd, err := context.InitCommand(ctx)
+		if err != nil {
+			return err
+		}
 		filter, err := flags.NotificationStateFlag.GetValues(ctx)
 		if err != nil {
 			return err
@@ -40,10 +45,13 @@ var CmdNotificationsMarkUnread = cli.Command{
 	Aliases:     []string{"u"},
 	Usage:       "Mark all filtered or a specific notification as unread",
 	Description: "Mark all filtered or a specific notification as unread",
-	ArgsUsage:   "[all | <notification id>]",
+	ArgsUsage:   "[all | <notification id> | <issue or pull reference>]",
 	Flags:       flags.NotificationFlags,
 	Action: func(ctx *cli.Context) error {
-		cmd := context.InitCommand(ctx)
+		cmd, err := context.InitCommand(ctx)
+		if err != nil {
+			return err
+		}
 		filter, err := flags.NotificationStateFlag.GetValues(ctx)
 		if err != nil {
 			return err
@@ -61,10 +69,13 @@ var CmdNotificationsMarkPinned = cli.Command{
 	Aliases:     []string{"p"},
 	Usage:       "Mark all filtered or a specific notification as pinned",
 	Description: "Mark all filtered or a specific notification as pinned",
-	ArgsUsage:   "[all | <notification id>]",
+	ArgsUsage:   "[all | <notification id> | <issue or pull reference>]",
 	Flags:       flags.NotificationFlags,
 	Action: func(ctx *cli.Context) error {
-		cmd := context.InitCommand(ctx)
+		cmd, err := context.InitCommand(ctx)
+		if err != nil {
+			return err
+		}
 		filter, err := flags.NotificationStateFlag.GetValues(ctx)
 		if err != nil {
 			return err
@@ -81,10 +92,13 @@ var CmdNotificationsUnpin = cli.Command{
 	Name:        "unpin",
 	Usage:       "Unpin all pinned or a specific notification",
 	Description: "Marks all pinned or a specific notification as read",
-	ArgsUsage:   "[all | <notification id>]",
+	ArgsUsage:   "[all | <notification id> | <issue or pull reference>]",
 	Flags:       flags.NotificationFlags,
 	Action: func(ctx *cli.Context) error {
-		cmd := context.InitCommand(ctx)
+		cmd, err := context.InitCommand(ctx)
+		if err != nil {
+			return err
+		}
 		filter := []string{string(gitea.NotifyStatusPinned)}
 		// NOTE: we implicitly mark it as read, to match web UI semantics. marking as unread might be more useful?
 		return markNotificationAs(cmd, filter, gitea.NotifyStatusRead)
@@ -105,29 +119,34 @@ func markNotificationAs(cmd *context.TeaContext, filterStates []string, targetSt
 	case "", "all":
 		opts := gitea.MarkNotificationOptions{Status: states, ToStatus: targetState}
 
+		var resp *gitea.Response
 		if allRepos {
-			_, _, err = client.ReadNotifications(opts)
+			_, resp, err = client.ReadNotifications(opts)
 		} else {
-			cmd.Ensure(context.CtxRequirement{RemoteRepo: true})
-			_, _, err = client.ReadRepoNotifications(cmd.Owner, cmd.Repo, opts)
+			if err := cmd.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+				return err
+			}
+			_, resp, err = client.ReadRepoNotifications(cmd.Owner, cmd.Repo, opts)
 		}
+		err = config.ClassifyAPIError(resp, err)
 
 		// TODO: print all affected notification subject URLs
 		// (not supported by API currently, https://github.com/go-gitea/gitea/issues/16797)
 
 	default:
-		id, err := utils.ArgToIndex(subject)
+		id, err := notificationID(cmd, subject)
 		if err != nil {
 			return err
 		}
-		_, _, err = client.ReadNotification(id, targetState)
+		client = cmd.Login.Client()
+		_, resp, err := client.ReadNotification(id, targetState)
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 
-		n, _, err := client.GetNotification(id)
+		n, resp, err := client.GetNotification(id)
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 		// FIXME: this is an API URL, we want to display a web ui link..
 		fmt.Println(n.Subject.URL)
@@ -136,3 +155,45 @@ func markNotificationAs(cmd *context.TeaContext, filterStates []string, targetSt
 
 	return err
 }
+
+// notificationID returns the id given as subject, or the id of the
+// notification of an issue or pull request given as owner/repo#index or URL
+func notificationID(cmd *context.TeaContext, subject string) (int64, error) {
+	ref, err := utils.ParseIndexRef(subject)
+	if err != nil {
+		return 0, err
+	}
+	if len(ref.Owner) == 0 {
+		return ref.Index, nil
+	}
+	if _, err := cmd.ArgToIndex(subject); err != nil {
+		return 0, err
+	}
+
+	client := cmd.Login.Client()
+	var id int64
+	err = task.ForEachPage(func(opt gitea.ListOptions) ([]*gitea.NotificationThread, *gitea.Response, error) {
+		return client.ListRepoNotifications(cmd.Owner, cmd.Repo, gitea.ListNotificationOptions{
+			ListOptions: opt,
+			Status:      []gitea.NotifyStatus{gitea.NotifyStatusUnread, gitea.NotifyStatusRead, gitea.NotifyStatusPinned},
+		})
+	}, func(threads []*gitea.NotificationThread) bool {
+		for _, n := range threads {
+			if n.Subject == nil {
+				continue
+			}
+			if r, err := utils.ParseIndexRef(n.Subject.HTMLURL); err == nil && r.Index == ref.Index {
+				id = n.ID
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, utils.NewNotExistErrorf("no notification for %s#%d", ref.RepoSlug(), ref.Index)
+	}
+	return id, nil
+}
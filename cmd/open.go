@@ -4,7 +4,9 @@
 package cmd
 
 import (
+	"fmt"
 	"path"
+	"slices"
 	"strings"
 
 	"code.gitea.io/tea/cmd/flags"
@@ -26,12 +28,28 @@ var CmdOpen = cli.Command{
 	Flags:       append([]cli.Flag{}, flags.LoginRepoFlags...),
 }
 
+// openTargets are the pages of a repo which can be opened by name
+var openTargets = []string{"issues", "pulls", "releases", "commits", "branches", "wiki", "activity", "settings", "labels", "milestones"}
+
 func runOpen(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
-	var suffix string
+	// anything but a page is an issue or pull, possibly of another repo
 	number := ctx.Args().Get(0)
+	var index int64
+	if number != "" && !slices.ContainsFunc(openTargets, func(t string) bool { return strings.EqualFold(t, number) }) {
+		if index, err = ctx.ArgToIndex(number); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+
+	var suffix string
 	switch {
 	case strings.EqualFold(number, "issues"):
 		suffix = "issues"
@@ -67,10 +85,8 @@ func runOpen(cmd *cli.Context) error {
 		suffix = "labels"
 	case strings.EqualFold(number, "milestones"):
 		suffix = "milestones"
-	case number != "":
-		suffix = "issues/" + number
-	default:
-		suffix = number
+	case index != 0:
+		suffix = fmt.Sprintf("issues/%d", index)
 	}
 
 	return open.Run(path.Join(ctx.GetRemoteRepoHTMLURL(), suffix))
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"code.gitea.io/tea/cmd/organizations"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -29,7 +30,10 @@ var CmdOrgs = cli.Command{
 }
 
 func runOrganizations(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() == 1 {
 		return runOrganizationDetail(ctx)
 	}
@@ -37,9 +41,9 @@ func runOrganizations(cmd *cli.Context) error {
 }
 
 func runOrganizationDetail(ctx *context.TeaContext) error {
-	org, _, err := ctx.Login.Client().GetOrg(ctx.Args().First())
+	org, resp, err := ctx.Login.Client().GetOrg(ctx.Args().First())
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.OrganizationDetails(org)
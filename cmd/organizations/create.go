@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -52,7 +53,10 @@ var CmdOrganizationCreate = cli.Command{
 
 // RunOrganizationCreate sets up a new organization
 func RunOrganizationCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	if ctx.Args().Len() < 1 {
 		return fmt.Errorf("You have to specify the organization name you want to create")
@@ -70,7 +74,7 @@ func RunOrganizationCreate(cmd *cli.Context) error {
 		return fmt.Errorf("unknown visibility '%s'", ctx.String("visibility"))
 	}
 
-	org, _, err := ctx.Login.Client().CreateOrg(gitea.CreateOrgOption{
+	org, resp, err := ctx.Login.Client().CreateOrg(gitea.CreateOrgOption{
 		Name: ctx.Args().First(),
 		// FullName: , // not really meaningful for orgs (not displayed in webui, use description instead?)
 		Description:               ctx.String("description"),
@@ -80,7 +84,7 @@ func RunOrganizationCreate(cmd *cli.Context) error {
 		Visibility:                visibility,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.OrganizationDetails(org)
@@ -7,7 +7,11 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/utils"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -27,7 +31,10 @@ var CmdOrganizationDelete = cli.Command{
 
 // RunOrganizationDelete delete user organization
 func RunOrganizationDelete(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	client := ctx.Login.Client()
 
@@ -35,10 +42,19 @@ func RunOrganizationDelete(cmd *cli.Context) error {
 		return fmt.Errorf("You have to specify the organization name you want to delete")
 	}
 
-	response, err := client.DeleteOrg(ctx.Args().First())
+	orgName := ctx.Args().First()
+	confirmed, err := interact.Confirm(fmt.Sprintf("Delete organization '%s'? This can't be undone.", orgName))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return utils.NewAbortedErrorf("Deletion of organization '%s' aborted", orgName)
+	}
+
+	response, err := client.DeleteOrg(orgName)
 	if response != nil && response.StatusCode == 404 {
-		return fmt.Errorf("The given organization does not exist")
+		return utils.NewNotExistErrorf("The given organization does not exist")
 	}
 
-	return err
+	return config.ClassifyAPIError(response, err)
 }
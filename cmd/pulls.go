@@ -5,11 +5,17 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/cmd/issues"
 	"code.gitea.io/tea/cmd/pulls"
+	"code.gitea.io/tea/cmd/search"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
 	"code.gitea.io/tea/modules/utils"
 	"code.gitea.io/tea/modules/workaround"
 
@@ -19,34 +25,53 @@ import (
 
 // CmdPulls is the main command to operate on PRs
 var CmdPulls = cli.Command{
-	Name:        "pulls",
-	Aliases:     []string{"pull", "pr"},
-	Category:    catEntities,
-	Usage:       "Manage and checkout pull requests",
-	Description: `Lists PRs when called without argument. If PR index is provided, will show it in detail.`,
-	ArgsUsage:   "[<pull index>]",
-	Action:      runPulls,
+	Name:     "pulls",
+	Aliases:  []string{"pull", "pr"},
+	Category: catEntities,
+	Usage:    "Manage and checkout pull requests",
+	Description: `Lists PRs when called without argument. If PR index is provided, will show it in detail.
+If @<name> of a search saved with 'tea search save --pulls' is provided, runs that search.`,
+	ArgsUsage: "[<pull index> | @<saved search>]",
+	Action:    runPulls,
 	Flags: append([]cli.Flag{
 		&cli.BoolFlag{
 			Name:  "comments",
 			Usage: "Whether to display comments (will prompt if not provided & run interactively)",
 		},
-	}, pulls.CmdPullsList.Flags...),
+		&cli.BoolFlag{
+			Name:  "timeline",
+			Usage: "Display the timeline of events instead of the comments",
+		},
+	}, append(issues.TimelineFlags, pulls.CmdPullsList.Flags...)...),
 	Subcommands: []*cli.Command{
 		&pulls.CmdPullsList,
 		&pulls.CmdPullsCheckout,
 		&pulls.CmdPullsClean,
 		&pulls.CmdPullsCreate,
+		&pulls.CmdPullsEdit,
 		&pulls.CmdPullsClose,
 		&pulls.CmdPullsReopen,
 		&pulls.CmdPullsReview,
 		&pulls.CmdPullsApprove,
 		&pulls.CmdPullsReject,
 		&pulls.CmdPullsMerge,
+		&pulls.CmdPullsChecks,
+		&pulls.CmdPullsDiff,
+		&pulls.CmdPullsCommits,
+		&pulls.CmdPullsFiles,
+		&pulls.CmdPullsPin,
+		&pulls.CmdPullsUnpin,
+		&pulls.CmdPullsLock,
+		&pulls.CmdPullsUnlock,
+		&pulls.CmdPullsSubscribe,
+		&pulls.CmdPullsUnsubscribe,
 	},
 }
 
 func runPulls(ctx *cli.Context) error {
+	if strings.HasPrefix(ctx.Args().First(), "@") {
+		return search.RunSavedSearch(ctx, ctx.Args().First(), "pulls", ctx.Args().Tail())
+	}
 	if ctx.Args().Len() == 1 {
 		return runPullDetail(ctx, ctx.Args().First())
 	}
@@ -54,17 +79,33 @@ func runPulls(ctx *cli.Context) error {
 }
 
 func runPullDetail(cmd *cli.Context, index string) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	idx, err := utils.ArgToIndex(index)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if logins, err := flags.GetLogins(cmd); err != nil {
+		return err
+	} else if logins != nil {
+		return utils.NewInvalidArgumentErrorf("--all-logins and --logins only apply to listing pull requests")
+	}
+	idx, err := ctx.ArgToIndex(index)
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	var timeline *issues.TimelineOptions
+	if ctx.Bool("timeline") {
+		if timeline, err = issues.GetTimelineOptions(ctx); err != nil {
+			return err
+		}
+	}
 
 	client := ctx.Login.Client()
-	pr, _, err := client.GetPullRequest(ctx.Owner, ctx.Repo, idx)
+	pr, resp, err := client.GetPullRequest(ctx.Owner, ctx.Repo, idx)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	if err := workaround.FixPullHeadSha(client, pr); err != nil {
 		return err
@@ -82,8 +123,22 @@ func runPullDetail(cmd *cli.Context, index string) error {
 		fmt.Printf("error while loading CI: %v\n", err)
 	}
 
-	print.PullDetails(pr, reviews, ci)
+	reactions, _, err := client.GetIssueReactions(ctx.Owner, ctx.Repo, idx)
+	if err != nil {
+		fmt.Printf("error while loading reactions: %v\n", err)
+	}
+
+	// pin & subscription state are only available from the issue of the pull
+	_, extras, err := task.GetIssueWithExtras(ctx.Login, ctx.Owner, ctx.Repo, idx)
+	if err != nil {
+		fmt.Printf("error while loading pin & subscription state: %v\n", err)
+	}
 
+	print.PullDetails(pr, reviews, ci, reactions, extras)
+
+	if timeline != nil {
+		return issues.PrintTimeline(ctx, idx, timeline)
+	}
 	if pr.Comments > 0 {
 		err = interact.ShowCommentsMaybeInteractive(ctx, idx, pr.Comments)
 		if err != nil {
@@ -10,8 +10,8 @@ import (
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/task"
-	"code.gitea.io/tea/modules/utils"
 
+	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
@@ -33,15 +33,25 @@ var CmdPullsCheckout = cli.Command{
 }
 
 func runPullsCheckout(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{
 		LocalRepo:  true,
 		RemoteRepo: true,
-	})
-	if ctx.Args().Len() != 1 {
-		return fmt.Errorf("Must specify a PR index")
+	}); err != nil {
+		return err
+	}
+	if ctx.Args().Len() > 1 {
+		return fmt.Errorf("Must specify a single PR index")
+	}
+	var idx int64
+	if ctx.Args().Present() {
+		idx, err = ctx.ArgToIndex(ctx.Args().First())
+	} else {
+		idx, err = interact.SelectIssue(ctx, gitea.IssueTypePull, gitea.StateOpen)
 	}
-	idx, err := utils.ArgToIndex(ctx.Args().First())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+var checkFieldsFlag = flags.FieldsFlag(print.PullCheckFields, []string{
+	"context", "state", "description", "duration", "url",
+})
+
+// CmdPullsChecks lists the CI checks of a pull request
+var CmdPullsChecks = cli.Command{
+	Name:    "checks",
+	Aliases: []string{"ci"},
+	Usage:   "Show the CI checks of a pull request",
+	Description: `Show the latest state of each commit status of the head of a pull request.
+Defaults to the pull request of the current branch.
+
+Exits with an error if a check failed. With --watch, polls until all checks
+finished, so scripts can block on CI. Checks still pending after --timeout
+are reported as an error as well:
+
+	tea pulls checks --watch --required-only && ./deploy.sh`,
+	ArgsUsage: "[<pull index>]",
+	Action:    runPullsChecks,
+	Flags: append([]cli.Flag{
+		checkFieldsFlag,
+		&cli.BoolFlag{
+			Name:    "watch",
+			Aliases: []string{"w"},
+			Usage:   "Poll until all checks finished",
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "Time between polls with --watch",
+			Value: 10 * time.Second,
+		},
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "How long to wait for checks with --watch",
+			Value: time.Hour,
+		},
+		&cli.BoolFlag{
+			Name:  "fail-fast",
+			Usage: "Stop watching as soon as a check failed",
+		},
+		&cli.BoolFlag{
+			Name:  "required-only",
+			Usage: "Only show checks required by the branch protection of the base branch, failing if none are required",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runPullsChecks(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	fields, err := checkFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+	if ctx.Duration("interval") <= 0 || ctx.Duration("timeout") <= 0 {
+		return utils.NewInvalidArgumentErrorf("--interval and --timeout must be positive")
+	}
+
+	pr, err := getPullOrCurrent(ctx)
+	if err != nil {
+		return err
+	}
+	requiredOnly := ctx.Bool("required-only")
+	checks, err := task.ListPullChecks(ctx.Login, ctx.Owner, ctx.Repo, pr, requiredOnly)
+	if err != nil {
+		return err
+	}
+
+	timedOut := false
+	if ctx.Bool("watch") {
+		deadline := time.Now().Add(ctx.Duration("timeout"))
+		var progress string
+		for {
+			pending, failed := countChecks(checks)
+			if pending == 0 || (failed != 0 && ctx.Bool("fail-fast")) {
+				break
+			}
+			if time.Now().Add(ctx.Duration("interval")).After(deadline) {
+				timedOut = true
+				break
+			}
+			if p := fmt.Sprintf("%d of %d checks finished, %d failed", len(checks)-pending, len(checks), failed); p != progress {
+				fmt.Fprintf(os.Stderr, "#%d: %s\n", pr.Index, p)
+				progress = p
+			}
+			time.Sleep(ctx.Duration("interval"))
+			// the head may have moved by a push in the meantime
+			updated, resp, err := ctx.Login.Client().GetPullRequest(ctx.Owner, ctx.Repo, pr.Index)
+			if err != nil {
+				return config.ClassifyAPIError(resp, err)
+			}
+			pr = updated
+			if checks, err = task.ListPullChecks(ctx.Login, ctx.Owner, ctx.Repo, pr, requiredOnly); err != nil {
+				return err
+			}
+		}
+	}
+
+	print.PullChecksList(checks, ctx.Output, fields)
+	pending, failed := countChecks(checks)
+	if failed != 0 {
+		return fmt.Errorf("%d checks of #%d failed", failed, pr.Index)
+	}
+	if timedOut {
+		return fmt.Errorf("timed out after %s with %d checks of #%d pending", ctx.Duration("timeout"), pending, pr.Index)
+	}
+	return nil
+}
+
+// getPullOrCurrent returns the pull request given as argument, or the one of
+// the current branch of the local repo. It ensures a remote repo is set in ctx.
+func getPullOrCurrent(ctx *context.TeaContext) (*gitea.PullRequest, error) {
+	if ctx.Args().Len() > 1 {
+		return nil, utils.NewInvalidArgumentErrorf("Must specify a single PR index")
+	}
+	if ctx.Args().Len() == 1 {
+		idx, err := ctx.ArgToIndex(ctx.Args().First())
+		if err != nil {
+			return nil, err
+		}
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+			return nil, err
+		}
+		pr, resp, err := ctx.Login.Client().GetPullRequest(ctx.Owner, ctx.Repo, idx)
+		return pr, config.ClassifyAPIError(resp, err)
+	}
+	if ctx.LocalRepo == nil {
+		return nil, utils.NewInvalidArgumentErrorf("Must specify a PR index outside of a local repo")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return nil, err
+	}
+	branch, _, err := ctx.LocalRepo.TeaGetCurrentBranchNameAndSHA()
+	if err != nil {
+		return nil, err
+	}
+	return task.FindPullForBranch(ctx.Login, ctx.Owner, ctx.Repo, branch)
+}
+
+// countChecks returns the number of pending and of failed checks
+func countChecks(checks []*print.PullCheck) (pending, failed int) {
+	for _, c := range checks {
+		switch c.State {
+		case gitea.StatusPending:
+			pending++
+		case gitea.StatusFailure, gitea.StatusError:
+			failed++
+		}
+	}
+	return pending, failed
+}
@@ -10,7 +10,6 @@ import (
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/task"
-	"code.gitea.io/tea/modules/utils"
 
 	"github.com/urfave/cli/v2"
 )
@@ -31,13 +30,18 @@ var CmdPullsClean = cli.Command{
 }
 
 func runPullsClean(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{LocalRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{LocalRepo: true}); err != nil {
+		return err
+	}
 	if ctx.Args().Len() != 1 {
 		return fmt.Errorf("Must specify a PR index")
 	}
 
-	idx, err := utils.ArgToIndex(ctx.Args().First())
+	idx, err := ctx.ArgToIndex(ctx.Args().First())
 	if err != nil {
 		return err
 	}
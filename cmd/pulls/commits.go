@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+
+	"github.com/urfave/cli/v2"
+)
+
+var commitFieldsFlag = flags.FieldsFlag(print.PullCommitFields, []string{
+	"sha", "author", "date", "subject", "verified",
+})
+
+// CmdPullsCommits lists the commits of a pull request
+var CmdPullsCommits = cli.Command{
+	Name:        "commits",
+	Usage:       "List the commits of a pull request",
+	Description: `List all commits of a pull request, defaulting to the pull request of the current branch`,
+	ArgsUsage:   "[<pull index>]",
+	Action:      runPullsCommits,
+	Flags:       append([]cli.Flag{commitFieldsFlag}, flags.AllDefaultFlags...),
+}
+
+func runPullsCommits(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	fields, err := commitFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	pr, err := getPullOrCurrent(ctx)
+	if err != nil {
+		return err
+	}
+	commits, err := task.ListPullCommits(ctx.Login, ctx.Owner, ctx.Repo, pr.Index)
+	if err != nil {
+		return err
+	}
+	print.PullCommitsList(commits, ctx.Output, fields)
+	return nil
+}
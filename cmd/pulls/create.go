@@ -14,11 +14,15 @@ import (
 
 // CmdPullsCreate creates a pull request
 var CmdPullsCreate = cli.Command{
-	Name:        "create",
-	Aliases:     []string{"c"},
-	Usage:       "Create a pull-request",
-	Description: "Create a pull-request in the current repo",
-	Action:      runPullsCreate,
+	Name:    "create",
+	Aliases: []string{"c"},
+	Usage:   "Create a pull-request",
+	Description: `Create a pull-request in the current repo.
+
+Pull requests can be drafted in a markdown file with YAML front matter, and created via --file.
+Besides the properties of issues, the front matter may set base and head.
+A file may hold several pull requests, each starting with its own front matter block.`,
+	Action: runPullsCreate,
 	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:  "head",
@@ -39,10 +43,13 @@ var CmdPullsCreate = cli.Command{
 }
 
 func runPullsCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	// no args -> interactive mode
-	if ctx.NumFlags() == 0 {
+	if ctx.NumFlags() == 0 || (ctx.NumFlags() == 1 && ctx.IsSet("template")) {
 		return interact.CreatePull(ctx)
 	}
 
@@ -52,6 +59,35 @@ func runPullsCreate(cmd *cli.Context) error {
 		return err
 	}
 
+	if name := ctx.String("template"); len(name) != 0 {
+		templates, err := task.FindPullTemplates(ctx)
+		if err != nil {
+			return err
+		}
+		tmpl, err := task.GetTemplate(templates, name)
+		if err != nil {
+			return err
+		}
+		if err = task.ApplyTemplate(ctx, tmpl, opts); err != nil {
+			return err
+		}
+	}
+
+	if file := ctx.String("file"); len(file) != 0 {
+		drafts, err := task.ReadIssueDrafts(file, ctx.App.Reader)
+		if err != nil {
+			return err
+		}
+		return task.CreatePullsFromDrafts(
+			ctx,
+			drafts,
+			*opts,
+			ctx.String("base"),
+			ctx.String("head"),
+			ctx.Bool("allow-maintainer-edits"),
+		)
+	}
+
 	return task.CreatePull(
 		ctx,
 		ctx.String("base"),
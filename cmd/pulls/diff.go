@@ -0,0 +1,111 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdPullsDiff shows the diff of a pull request
+var CmdPullsDiff = cli.Command{
+	Name:  "diff",
+	Usage: "Show the changes of a pull request",
+	Description: `Show the diff of a pull request, defaulting to the pull request of the
+current branch. Output to a terminal is colored, with the changed code
+highlighted by its language, and paged with $PAGER.
+
+	tea pulls diff --stat 42
+	tea pulls diff --path docs/ --path '*.go' 42
+	tea pulls diff --patch 42 | git am`,
+	ArgsUsage: "[<pull index>]",
+	Action:    runPullsDiff,
+	Flags: append([]cli.Flag{
+		&cli.BoolFlag{
+			Name:  "stat",
+			Usage: "Show the number of changed lines per file",
+		},
+		&cli.BoolFlag{
+			Name:  "name-only",
+			Usage: "Only show the paths of changed files",
+		},
+		&cli.StringSliceFlag{
+			Name:    "path",
+			Aliases: []string{"P"},
+			Usage:   "Only show files matching a path, directory or glob pattern. Can be repeated",
+		},
+		&cli.BoolFlag{
+			Name:  "patch",
+			Usage: "Show the commits as a series of patches, as generated by git format-patch",
+		},
+		&cli.StringFlag{
+			Name:  "color",
+			Usage: "When to color the output (auto|always|never)",
+			Value: "auto",
+		},
+		&cli.BoolFlag{
+			Name:  "no-pager",
+			Usage: "Do not page the output",
+		},
+	}, flags.AllDefaultFlags...),
+}
+
+func runPullsDiff(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	var color bool
+	switch ctx.String("color") {
+	case "auto":
+		color = print.IsInteractive()
+	case "always":
+		color = true
+	case "never":
+	default:
+		return utils.NewInvalidArgumentErrorf("invalid --color '%s', must be auto, always or never", ctx.String("color"))
+	}
+	pager := !ctx.Bool("no-pager")
+	if ctx.Bool("stat") && ctx.Bool("name-only") {
+		return utils.NewInvalidArgumentErrorf("--stat and --name-only are mutually exclusive")
+	}
+	if ctx.Bool("patch") && (ctx.Bool("stat") || ctx.Bool("name-only") || ctx.IsSet("path")) {
+		return utils.NewInvalidArgumentErrorf("--patch can't be combined with --stat, --name-only or --path")
+	}
+
+	pr, err := getPullOrCurrent(ctx)
+	if err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
+
+	if ctx.Bool("patch") {
+		patch, resp, err := client.GetPullRequestPatch(ctx.Owner, ctx.Repo, pr.Index)
+		if err != nil {
+			return config.ClassifyAPIError(resp, err)
+		}
+		return print.DiffText(string(patch), color, pager)
+	}
+
+	diff, resp, err := client.GetPullRequestDiff(ctx.Owner, ctx.Repo, pr.Index, gitea.PullRequestDiffOptions{})
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+	files := task.FilterDiff(task.ParseDiff(string(diff)), ctx.StringSlice("path"))
+	switch {
+	case ctx.Bool("name-only"):
+		print.DiffNames(files)
+		return nil
+	case ctx.Bool("stat"):
+		return print.DiffStat(files, color, pager)
+	}
+	return print.Diff(files, color, pager)
+}
@@ -6,38 +6,113 @@ package pulls
 import (
 	"fmt"
 
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
 	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
+// CmdPullsEdit is the subcommand of pulls to edit pull requests
+var CmdPullsEdit = cli.Command{
+	Name:    "edit",
+	Aliases: []string{"e"},
+	Usage:   "Edit one or more pull requests",
+	Description: `Edit one or more pull requests. To unset a property again,
+use an empty string (eg. --milestone "").
+
+Assignees can be added, removed or replaced; '@me' refers to the login user:
+
+	tea pulls edit --remove-assignees alice --add-assignees @me 42`,
+	ArgsUsage: "<idx> [<idx>...]",
+	Action:    runPullsEdit,
+	Flags:     flags.IssuePREditFlags,
+}
+
+func runPullsEdit(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	indices, err := ctx.ArgsToIndices(ctx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+
+	opts, err := flags.GetIssuePREditFlags(ctx)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		idx, err := interact.SelectIssue(ctx, gitea.IssueTypePull, gitea.StateOpen)
+		if err != nil {
+			return err
+		}
+		indices = []int64{idx}
+	}
+
+	// pulls are issues too, so the issue API covers all editable properties
+	client := ctx.Login.Client()
+	for _, opts.Index = range indices {
+		issue, err := task.EditIssue(ctx, client, *opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if len(indices) > 1 {
+			fmt.Println(issue.HTMLURL)
+			continue
+		}
+		pr, resp, err := client.GetPullRequest(ctx.Owner, ctx.Repo, opts.Index)
+		if err != nil {
+			return config.ClassifyAPIError(resp, err)
+		}
+		print.PullDetails(pr, nil, nil, nil, nil)
+	}
+	return nil
+}
+
 // editPullState abstracts the arg parsing to edit the given pull request
 func editPullState(cmd *cli.Context, opts gitea.EditPullRequestOption) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() == 0 {
 		return fmt.Errorf("Please provide a Pull Request index")
 	}
 
-	indices, err := utils.ArgsToIndices(ctx.Args().Slice())
+	indices, err := ctx.ArgsToIndices(ctx.Args().Slice())
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	client := ctx.Login.Client()
 	for _, index := range indices {
-		pr, _, err := client.EditPullRequest(ctx.Owner, ctx.Repo, index, opts)
-		if err != nil {
-			return err
+		pr, resp, err := client.EditPullRequest(ctx.Owner, ctx.Repo, index, opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return config.ClassifyAPIError(resp, err)
 		}
 
 		if len(indices) > 1 {
 			fmt.Println(pr.HTMLURL)
 		} else {
-			print.PullDetails(pr, nil, nil)
+			print.PullDetails(pr, nil, nil, nil, nil)
 		}
 	}
 	return nil
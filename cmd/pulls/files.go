@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+
+	"github.com/urfave/cli/v2"
+)
+
+var fileFieldsFlag = flags.FieldsFlag(print.PullFileFields, []string{
+	"status", "path", "previous-path", "additions", "deletions",
+})
+
+// CmdPullsFiles lists the files changed by a pull request
+var CmdPullsFiles = cli.Command{
+	Name:        "files",
+	Usage:       "List the files changed by a pull request",
+	Description: `List all files changed by a pull request, defaulting to the pull request of the current branch`,
+	ArgsUsage:   "[<pull index>]",
+	Action:      runPullsFiles,
+	Flags:       append([]cli.Flag{fileFieldsFlag}, flags.AllDefaultFlags...),
+}
+
+func runPullsFiles(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	fields, err := fileFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	pr, err := getPullOrCurrent(ctx)
+	if err != nil {
+		return err
+	}
+	files, err := task.ListPullFiles(ctx.Login, ctx.Owner, ctx.Repo, pr.Index)
+	if err != nil {
+		return err
+	}
+	print.PullFilesList(files, ctx.Output, fields)
+	return nil
+}
@@ -4,33 +4,60 @@
 package pulls
 
 import (
+	"slices"
+	"strings"
+	"sync"
+
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
-var pullFieldsFlag = flags.FieldsFlag(print.PullFields, []string{
+var pullFieldsFlag = flags.LoginFieldsFlag(print.PullFields, []string{
 	"index", "title", "state", "author", "milestone", "updated", "labels",
 })
 
 // CmdPullsList represents a sub command of issues to list pulls
 var CmdPullsList = cli.Command{
-	Name:        "list",
-	Aliases:     []string{"ls"},
-	Usage:       "List pull requests of the repository",
-	Description: `List pull requests of the repository`,
-	ArgsUsage:   " ", // command does not accept arguments
-	Action:      RunPullsList,
-	Flags:       append([]cli.Flag{pullFieldsFlag}, flags.PRListingFlags...),
+	Name:    "list",
+	Aliases: []string{"ls"},
+	Usage:   "List pull requests of the repository",
+	Description: `List pull requests of the repository.
+
+Filters which the API does not support are applied by tea, which pages through
+the pull requests of the repository until --limit matching ones are found, so
+they may take a while on large repositories. Filtering by --ci, --reviewer or
+--review-requested fetches the status or reviews of each PR.
+
+With --all-logins or --logins, the repository given with --repo is listed on
+each login, eg. to compare mirrors of a repository on several instances.`,
+	ArgsUsage: " ", // command does not accept arguments
+	Action:    RunPullsList,
+	Flags:     append(append([]cli.Flag{pullFieldsFlag}, flags.LoginsFlags...), flags.PRListingFlags...),
 }
 
 // RunPullsList return list of pulls
 func RunPullsList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	logins, err := flags.GetLogins(cmd)
+	if err != nil {
+		return err
+	}
+	if logins != nil && !ctx.IsSet("repo") {
+		return utils.NewInvalidArgumentErrorf("--all-logins and --logins require --repo <owner>/<repo>, as the repository of the local git remote belongs to a single login")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	state := gitea.StateOpen
 	switch ctx.String("state") {
@@ -42,19 +69,76 @@ func RunPullsList(cmd *cli.Context) error {
 		state = gitea.StateClosed
 	}
 
-	prs, _, err := ctx.Login.Client().ListRepoPullRequests(ctx.Owner, ctx.Repo, gitea.ListPullRequestsOptions{
-		State: state,
-	})
-
+	fields, err := pullFieldsFlag.GetValues(cmd)
 	if err != nil {
 		return err
 	}
 
-	fields, err := pullFieldsFlag.GetValues(cmd)
+	opt := gitea.ListPullRequestsOptions{
+		ListOptions: ctx.GetListOptions(),
+		State:       state,
+	}
+	if ctx.IsSet("sort") {
+		if !slices.Contains(flags.PRSortValues, ctx.String("sort")) {
+			return utils.NewInvalidArgumentErrorf("invalid --sort '%s', must be one of %s", ctx.String("sort"), strings.Join(flags.PRSortValues, ", "))
+		}
+		opt.Sort = ctx.String("sort")
+	}
+
+	filter := task.PullFilter{
+		Author:          ctx.String("author"),
+		Base:            ctx.String("base"),
+		Head:            ctx.String("head"),
+		Reviewer:        ctx.String("reviewer"),
+		ReviewRequested: ctx.String("review-requested"),
+		CI:              ctx.String("ci"),
+	}
+	if ctx.String("labels") != "" {
+		// ignore error, as we don't do any input validation on these flags
+		filter.Labels, _ = flags.LabelFilterFlag.GetValues(cmd)
+	}
+	if ctx.IsSet("draft") {
+		draft := ctx.Bool("draft")
+		filter.Draft = &draft
+	}
+	if ctx.IsSet("mergeable") && ctx.IsSet("conflicting") {
+		return utils.NewInvalidArgumentErrorf("--mergeable and --conflicting are mutually exclusive")
+	}
+	if ctx.IsSet("mergeable") {
+		mergeable := ctx.Bool("mergeable")
+		filter.Mergeable = &mergeable
+	} else if ctx.IsSet("conflicting") {
+		mergeable := !ctx.Bool("conflicting")
+		filter.Mergeable = &mergeable
+	}
+	switch filter.CI {
+	case "", "none", string(gitea.StatusSuccess), string(gitea.StatusPending), string(gitea.StatusFailure), string(gitea.StatusError), string(gitea.StatusWarning):
+	default:
+		return utils.NewInvalidArgumentErrorf("invalid --ci '%s'", filter.CI)
+	}
+
+	if logins == nil && slices.Contains(fields, "login") {
+		logins = []*config.Login{ctx.Login}
+	}
+	if logins != nil {
+		var mutex sync.Mutex
+		extras := map[*gitea.PullRequest]*print.PullExtras{}
+		listings := task.ForEachLogin(logins, func(login *config.Login) ([]*gitea.PullRequest, error) {
+			prs, e, err := task.ListPulls(login, ctx.Owner, ctx.Repo, ctx.String("milestone"), opt, filter, fields)
+			mutex.Lock()
+			defer mutex.Unlock()
+			for pr, x := range e {
+				extras[pr] = x
+			}
+			return prs, err
+		})
+		return print.PullsListPerLogin(listings, extras, ctx.Output, fields)
+	}
+
+	prs, extras, err := task.ListPulls(ctx.Login, ctx.Owner, ctx.Repo, ctx.String("milestone"), opt, filter, fields)
 	if err != nil {
 		return err
 	}
-
-	print.PullsList(prs, ctx.Output, fields)
+	print.PullsListWithExtras(prs, extras, ctx.Output, fields)
 	return nil
 }
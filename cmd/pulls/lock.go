@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/cmd/issues"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdPullsLock represents a sub command of pulls to lock conversations
+var CmdPullsLock = cli.Command{
+	Name:        "lock",
+	Usage:       "Lock the conversation of one or more pull requests",
+	Description: `Lock the conversation of one or more pull requests, so only collaborators can comment`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateAll, issues.LockAction(true))
+	},
+	Flags: issues.LockFlags,
+}
+
+// CmdPullsUnlock represents a sub command of pulls to unlock conversations
+var CmdPullsUnlock = cli.Command{
+	Name:        "unlock",
+	Usage:       "Unlock the conversation of one or more pull requests",
+	Description: `Unlock the conversation of one or more pull requests`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateAll, issues.LockAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
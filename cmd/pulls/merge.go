@@ -4,6 +4,10 @@
 package pulls
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/cmd/flags"
 	"code.gitea.io/tea/modules/context"
@@ -16,11 +20,17 @@ import (
 
 // CmdPullsMerge merges a PR
 var CmdPullsMerge = cli.Command{
-	Name:        "merge",
-	Aliases:     []string{"m"},
-	Usage:       "Merge a pull request",
-	Description: "Merge a pull request",
-	ArgsUsage:   "<pull index>",
+	Name:    "merge",
+	Aliases: []string{"m"},
+	Usage:   "Merge a pull request",
+	Description: `Merge a pull request.
+
+With --auto, the pull request is merged as soon as its checks succeed. Servers
+since gitea 1.17 schedule the merge, which can be canceled with --cancel-auto.
+On older servers tea waits for checks, required approvals and conflicts
+itself, and merges once nothing blocks the pull request.
+--auto and --cancel-auto default to the pull request of the current branch.`,
+	ArgsUsage: "<pull index>",
 	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:    "style",
@@ -38,25 +48,110 @@ var CmdPullsMerge = cli.Command{
 			Aliases: []string{"m"},
 			Usage:   "Merge commit message",
 		},
+		&cli.BoolFlag{
+			Name:    "delete-branch",
+			Aliases: []string{"d"},
+			Usage:   "Delete the head branch after merging",
+		},
+		&cli.BoolFlag{
+			Name:  "auto",
+			Usage: "Merge when checks succeed",
+		},
+		&cli.BoolFlag{
+			Name:  "cancel-auto",
+			Usage: "Cancel a scheduled auto merge",
+		},
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "How long to wait with --auto on servers which can't schedule merges",
+			Value: time.Hour,
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "Time between polls with --auto on servers which can't schedule merges",
+			Value: 30 * time.Second,
+		},
 	}, flags.AllDefaultFlags...),
-	Action: func(cmd *cli.Context) error {
-		ctx := context.InitCommand(cmd)
-		ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	Action: runPullsMerge,
+}
+
+func runPullsMerge(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	opt := gitea.MergePullRequestOption{
+		Style:                  gitea.MergeStyle(ctx.String("style")),
+		Title:                  ctx.String("title"),
+		Message:                ctx.String("message"),
+		DeleteBranchAfterMerge: ctx.Bool("delete-branch"),
+	}
 
+	auto, cancel := ctx.Bool("auto"), ctx.Bool("cancel-auto")
+	if auto && cancel {
+		return utils.NewInvalidArgumentErrorf("--auto and --cancel-auto are mutually exclusive")
+	}
+	if !auto && !cancel {
 		if ctx.Args().Len() != 1 {
+			if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+				return err
+			}
 			// If no PR index is provided, try interactive mode
-			return interact.MergePull(ctx)
+			return interact.MergePull(ctx, opt)
 		}
+		idx, err := ctx.ArgToIndex(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+			return err
+		}
+		return task.PullMerge(ctx.Login, ctx.Owner, ctx.Repo, idx, opt)
+	}
 
-		idx, err := utils.ArgToIndex(ctx.Args().First())
+	pr, err := getPullOrCurrent(ctx)
+	if err != nil {
+		return err
+	}
+	if cancel {
+		if err := task.CancelPullAutoMerge(ctx.Login, ctx.Owner, ctx.Repo, pr.Index); err != nil {
+			return err
+		}
+		fmt.Printf("Canceled auto merge of #%d\n", pr.Index)
+		return nil
+	}
+
+	supported, err := task.SupportsAutoMerge(ctx.Login)
+	if err != nil {
+		return err
+	}
+	if supported {
+		merged, err := task.PullAutoMerge(ctx.Login, ctx.Owner, ctx.Repo, pr.Index, opt)
 		if err != nil {
 			return err
 		}
+		if merged {
+			fmt.Printf("Merged #%d\n", pr.Index)
+		} else {
+			fmt.Printf("#%d will be merged when checks succeed\n", pr.Index)
+		}
+		return nil
+	}
 
-		return task.PullMerge(ctx.Login, ctx.Owner, ctx.Repo, idx, gitea.MergePullRequestOption{
-			Style:   gitea.MergeStyle(ctx.String("style")),
-			Title:   ctx.String("title"),
-			Message: ctx.String("message"),
-		})
-	},
+	if ctx.Duration("interval") <= 0 || ctx.Duration("timeout") <= 0 {
+		return utils.NewInvalidArgumentErrorf("--interval and --timeout must be positive")
+	}
+	fmt.Fprintln(os.Stderr, "The server can't schedule merges, waiting for the pull request to become mergeable")
+	err = task.WaitForPullMergeable(ctx.Login, ctx.Owner, ctx.Repo, pr.Index, ctx.Duration("interval"), ctx.Duration("timeout"), func(waitingFor string) {
+		fmt.Fprintf(os.Stderr, "#%d: waiting for %s\n", pr.Index, waitingFor)
+	})
+	if err != nil {
+		return err
+	}
+	if err := task.PullMerge(ctx.Login, ctx.Owner, ctx.Repo, pr.Index, opt); err != nil {
+		return err
+	}
+	fmt.Printf("Merged #%d\n", pr.Index)
+	return nil
 }
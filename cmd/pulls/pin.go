@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/cmd/issues"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdPullsPin represents a sub command of pulls to pin pull requests
+var CmdPullsPin = cli.Command{
+	Name:        "pin",
+	Usage:       "Pin one or more pull requests to the top of the pull request list",
+	Description: `Pin one or more pull requests to the top of the pull request list`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateOpen, issues.PinAction(true))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// CmdPullsUnpin represents a sub command of pulls to unpin pull requests
+var CmdPullsUnpin = cli.Command{
+	Name:        "unpin",
+	Usage:       "Unpin one or more pull requests",
+	Description: `Unpin one or more pull requests`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateAll, issues.PinAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
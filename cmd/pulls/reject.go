@@ -10,7 +10,6 @@ import (
 	"code.gitea.io/tea/cmd/flags"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/task"
-	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -23,17 +22,22 @@ var CmdPullsReject = cli.Command{
 	Description: "Request changes to a pull request",
 	ArgsUsage:   "<pull index> <reason>",
 	Action: func(cmd *cli.Context) error {
-		ctx := context.InitCommand(cmd)
-		ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+		ctx, err := context.InitCommand(cmd)
+		if err != nil {
+			return err
+		}
 
 		if ctx.Args().Len() < 2 {
 			return fmt.Errorf("Must specify a PR index and comment")
 		}
 
-		idx, err := utils.ArgToIndex(ctx.Args().First())
+		idx, err := ctx.ArgToIndex(ctx.Args().First())
 		if err != nil {
 			return err
 		}
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+			return err
+		}
 
 		comment := strings.Join(ctx.Args().Tail(), " ")
 
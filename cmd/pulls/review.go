@@ -9,8 +9,8 @@ import (
 	"code.gitea.io/tea/cmd/flags"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/interact"
-	"code.gitea.io/tea/modules/utils"
 
+	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
@@ -21,17 +21,29 @@ var CmdPullsReview = cli.Command{
 	Description: "Interactively review a pull request",
 	ArgsUsage:   "<pull index>",
 	Action: func(cmd *cli.Context) error {
-		ctx := context.InitCommand(cmd)
-		ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+		ctx, err := context.InitCommand(cmd)
+		if err != nil {
+			return err
+		}
 
-		if ctx.Args().Len() != 1 {
-			return fmt.Errorf("Must specify a PR index")
+		if ctx.Args().Len() > 1 {
+			return fmt.Errorf("Must specify a single PR index")
 		}
 
-		idx, err := utils.ArgToIndex(ctx.Args().First())
-		if err != nil {
+		var idx int64
+		if ctx.Args().Present() {
+			if idx, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+				return err
+			}
+		}
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
 			return err
 		}
+		if !ctx.Args().Present() {
+			if idx, err = interact.SelectIssue(ctx, gitea.IssueTypePull, gitea.StateOpen); err != nil {
+				return err
+			}
+		}
 
 		return interact.ReviewPull(ctx, idx)
 	},
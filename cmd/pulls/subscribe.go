@@ -0,0 +1,38 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pulls
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/cmd/issues"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdPullsSubscribe represents a sub command of pulls to watch pull requests
+var CmdPullsSubscribe = cli.Command{
+	Name:        "subscribe",
+	Aliases:     []string{"watch"},
+	Usage:       "Subscribe to notifications of one or more pull requests",
+	Description: `Subscribe to notifications of one or more pull requests`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateOpen, issues.SubscribeAction(true))
+	},
+	Flags: flags.LoginRepoFlags,
+}
+
+// CmdPullsUnsubscribe represents a sub command of pulls to unwatch pull requests
+var CmdPullsUnsubscribe = cli.Command{
+	Name:        "unsubscribe",
+	Aliases:     []string{"unwatch"},
+	Usage:       "Unsubscribe from notifications of one or more pull requests",
+	Description: `Unsubscribe from notifications of one or more pull requests`,
+	ArgsUsage:   "<pull index> [<pull index>...]",
+	Action: func(cmd *cli.Context) error {
+		return issues.RunForEachIssue(cmd, gitea.IssueTypePull, gitea.StateAll, issues.SubscribeAction(false))
+	},
+	Flags: flags.LoginRepoFlags,
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/urfave/cli/v2"
+)
+
+// reactionAliases maps alternative names to reactions, eg. for -1 which is
+// otherwise parsed as flag
+var reactionAliases = map[string]string{
+	"thumbsup":   "+1",
+	"thumbsdown": "-1",
+}
+
+// CmdReact is the command to add or remove reactions
+var CmdReact = cli.Command{
+	Name:     "react",
+	Category: catEntities,
+	Usage:    "Add or remove a reaction on an issue / pr or comment",
+	Description: `Add or remove a reaction on an issue / pr, or on one of its comments with --comment.
+Gitea supports +1, -1, laugh, hooray, confused, heart, rocket and eyes by default.
+When -1 is the first argument, it looks like a flag. Pass it after --, or use thumbsdown:
+
+	tea react 42 -1
+	tea react --remove 42 +1
+	tea react --comment 1337 -- -1`,
+	ArgsUsage: "[<issue / pr index>] <reaction>",
+	Action:    runReact,
+	Flags: append([]cli.Flag{
+		&cli.Int64Flag{
+			Name:  "comment",
+			Usage: "ID of the comment to react to, instead of the issue / pr itself",
+		},
+		&cli.BoolFlag{
+			Name:  "remove",
+			Usage: "Remove the reaction instead of adding it",
+		},
+	}, flags.LoginRepoFlags...),
+}
+
+func runReact(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	var args []string
+	for _, arg := range ctx.Args().Slice() {
+		if arg != "--" {
+			args = append(args, arg)
+		}
+	}
+	commentID := ctx.Int64("comment")
+	var idx int64
+	switch {
+	case commentID != 0 && len(args) == 1:
+		// the comment id identifies the issue already
+	case len(args) == 2:
+		if idx, err = ctx.ArgToIndex(args[0]); err != nil {
+			return err
+		}
+		args = args[1:]
+	default:
+		return utils.NewInvalidArgumentErrorf("Must specify an issue / pr index and a reaction")
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	reaction := strings.Trim(args[0], ":")
+	if alias, ok := reactionAliases[reaction]; ok {
+		reaction = alias
+	}
+
+	client := ctx.Login.Client()
+	var result *gitea.Reaction
+	var resp *gitea.Response
+	switch {
+	case commentID != 0 && ctx.Bool("remove"):
+		resp, err = client.DeleteIssueCommentReaction(ctx.Owner, ctx.Repo, commentID, reaction)
+	case commentID != 0:
+		result, resp, err = client.PostIssueCommentReaction(ctx.Owner, ctx.Repo, commentID, reaction)
+	case ctx.Bool("remove"):
+		resp, err = client.DeleteIssueReaction(ctx.Owner, ctx.Repo, idx, reaction)
+	default:
+		result, resp, err = client.PostIssueReaction(ctx.Owner, ctx.Repo, idx, reaction)
+	}
+	if err != nil {
+		return config.ClassifyAPIError(resp, err)
+	}
+	target := fmt.Sprintf("#%d", idx)
+	if commentID != 0 {
+		target = fmt.Sprintf("comment %d", commentID)
+	}
+	print.Reaction(result, reaction, target)
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	"path/filepath"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -62,8 +64,13 @@ var CmdReleaseCreate = cli.Command{
 }
 
 func runReleaseCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
 	tag := ctx.String("tag")
 	if cmd.Args().Present() {
@@ -84,9 +91,9 @@ func runReleaseCreate(cmd *cli.Context) error {
 
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusConflict {
-			return fmt.Errorf("There already is a release for this tag")
+			return utils.NewAlreadyExistErrorf("There already is a release for this tag")
 		}
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	for _, asset := range ctx.StringSlice("asset") {
@@ -98,9 +105,9 @@ func runReleaseCreate(cmd *cli.Context) error {
 
 		filePath := filepath.Base(asset)
 
-		if _, _, err = ctx.Login.Client().CreateReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, file, filePath); err != nil {
+		if _, resp, err := ctx.Login.Client().CreateReleaseAttachment(ctx.Owner, ctx.Repo, release.ID, file, filePath); err != nil {
 			file.Close()
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 
 		file.Close()
@@ -7,7 +7,10 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/urfave/cli/v2"
 )
@@ -34,33 +37,44 @@ var CmdReleaseDelete = cli.Command{
 }
 
 func runReleaseDelete(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
-
-	if !ctx.Args().Present() {
-		fmt.Println("Release tag needed to edit")
-		return nil
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
 
-	if !ctx.Bool("confirm") {
+	if !ctx.Bool("confirm") && !utils.AssumeYes() {
 		fmt.Println("Are you sure? Please confirm with -y or --confirm.")
 		return nil
 	}
 
-	for _, tag := range ctx.Args().Slice() {
-		release, err := getReleaseByTag(ctx.Owner, ctx.Repo, tag, client)
+	tags := ctx.Args().Slice()
+	if len(tags) == 0 {
+		tag, err := interact.SelectRelease(ctx)
 		if err != nil {
 			return err
 		}
-		_, err = client.DeleteRelease(ctx.Owner, ctx.Repo, release.ID)
+		tags = []string{tag}
+	}
+
+	for _, tag := range tags {
+		release, err := getReleaseByTag(ctx.Owner, ctx.Repo, tag, client)
 		if err != nil {
 			return err
 		}
+		resp, err := client.DeleteRelease(ctx.Owner, ctx.Repo, release.ID)
+		if err != nil && !utils.IsDryRun(err) {
+			return config.ClassifyAPIError(resp, err)
+		}
 
 		if ctx.Bool("delete-tag") {
-			_, err = client.DeleteTag(ctx.Owner, ctx.Repo, tag)
-			return err
+			resp, err = client.DeleteTag(ctx.Owner, ctx.Repo, tag)
+			if err != nil && !utils.IsDryRun(err) {
+				return config.ClassifyAPIError(resp, err)
+			}
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 
 	"code.gitea.io/sdk/gitea"
@@ -57,8 +58,13 @@ var CmdReleaseEdit = cli.Command{
 }
 
 func runReleaseEdit(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	var isDraft, isPre *bool
@@ -80,7 +86,7 @@ func runReleaseEdit(cmd *cli.Context) error {
 			return err
 		}
 
-		_, _, err = client.EditRelease(ctx.Owner, ctx.Repo, release.ID, gitea.EditReleaseOption{
+		_, resp, err := client.EditRelease(ctx.Owner, ctx.Repo, release.ID, gitea.EditReleaseOption{
 			TagName:      ctx.String("tag"),
 			Target:       ctx.String("target"),
 			Title:        ctx.String("title"),
@@ -89,7 +95,7 @@ func runReleaseEdit(cmd *cli.Context) error {
 			IsPrerelease: isPre,
 		})
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
 	}
 	return nil
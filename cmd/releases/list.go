@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -30,14 +31,19 @@ var CmdReleaseList = cli.Command{
 
 // RunReleasesList list releases
 func RunReleasesList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 
-	releases, _, err := ctx.Login.Client().ListReleases(ctx.Owner, ctx.Repo, gitea.ListReleasesOptions{
+	releases, resp, err := ctx.Login.Client().ListReleases(ctx.Owner, ctx.Repo, gitea.ListReleasesOptions{
 		ListOptions: ctx.GetListOptions(),
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.ReleasesList(releases, ctx.Output)
@@ -45,11 +51,11 @@ func RunReleasesList(cmd *cli.Context) error {
 }
 
 func getReleaseByTag(owner, repo, tag string, client *gitea.Client) (*gitea.Release, error) {
-	rl, _, err := client.ListReleases(owner, repo, gitea.ListReleasesOptions{
+	rl, resp, err := client.ListReleases(owner, repo, gitea.ListReleasesOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return nil, err
+		return nil, config.ClassifyAPIError(resp, err)
 	}
 	if len(rl) == 0 {
 		return nil, fmt.Errorf("Repo does not have any release")
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"code.gitea.io/tea/cmd/repos"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/utils"
@@ -42,16 +43,19 @@ func runRepos(ctx *cli.Context) error {
 }
 
 func runRepoDetail(cmd *cli.Context, path string) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 	repoOwner, repoName := utils.GetOwnerAndRepo(path, ctx.Owner)
-	repo, _, err := client.GetRepo(repoOwner, repoName)
+	repo, resp, err := client.GetRepo(repoOwner, repoName)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
-	topics, _, err := client.ListRepoTopics(repoOwner, repoName, gitea.ListRepoTopicsOptions{})
+	topics, resp, err := client.ListRepoTopics(repoOwner, repoName, gitea.ListRepoTopicsOptions{})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.RepoDetails(repo, topics)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -91,11 +92,14 @@ var CmdRepoCreate = cli.Command{
 }
 
 func runRepoCreate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 	var (
 		repo       *gitea.Repository
-		err        error
+		resp       *gitea.Response
 		trustmodel gitea.TrustModel
 	)
 
@@ -126,17 +130,17 @@ func runRepoCreate(cmd *cli.Context) error {
 		TrustModel:    trustmodel,
 	}
 	if len(ctx.String("owner")) != 0 {
-		repo, _, err = client.CreateOrgRepo(ctx.String("owner"), opts)
+		repo, resp, err = client.CreateOrgRepo(ctx.String("owner"), opts)
 	} else {
-		repo, _, err = client.CreateRepo(opts)
+		repo, resp, err = client.CreateRepo(opts)
 	}
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	topics, _, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
+	topics, resp, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	print.RepoDetails(repo, topics)
 
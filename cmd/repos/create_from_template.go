@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/utils"
@@ -82,7 +83,10 @@ var CmdRepoCreateFromTemplate = cli.Command{
 }
 
 func runRepoCreateFromTemplate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	templateOwner, templateRepo := utils.GetOwnerAndRepo(ctx.String("template"), ctx.Login.User)
@@ -104,14 +108,14 @@ func runRepoCreateFromTemplate(cmd *cli.Context) error {
 		Webhooks:    ctx.Bool("webhooks"),
 	}
 
-	repo, _, err := client.CreateRepoFromTemplate(templateOwner, templateRepo, opts)
+	repo, resp, err := client.CreateRepoFromTemplate(templateOwner, templateRepo, opts)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	topics, _, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
+	topics, resp, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	print.RepoDetails(repo, topics)
 
@@ -7,7 +7,10 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/interact"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/urfave/cli/v2"
@@ -45,7 +48,10 @@ var CmdRepoRm = cli.Command{
 }
 
 func runRepoDelete(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	client := ctx.Login.Client()
 
@@ -61,23 +67,23 @@ func runRepoDelete(cmd *cli.Context) error {
 
 	repoSlug := fmt.Sprintf("%s/%s", owner, repoName)
 
-	if !ctx.Bool("force") {
+	if !ctx.Bool("force") && !utils.AssumeYes() {
 		var enteredRepoSlug string
 		promptRepoName := &survey.Input{
 			Message: fmt.Sprintf("Confirm the deletion of the repository '%s' by typing its name: ", repoSlug),
 		}
-		if err := survey.AskOne(promptRepoName, &enteredRepoSlug, survey.WithValidator(survey.Required)); err != nil {
+		if err := interact.AskOne(promptRepoName, &enteredRepoSlug, "--force", survey.WithValidator(survey.Required)); err != nil {
 			return err
 		}
 
 		if enteredRepoSlug != repoSlug {
-			return fmt.Errorf("Entered wrong repository name '%s', expected '%s'", enteredRepoSlug, repoSlug)
+			return utils.NewAbortedErrorf("Entered wrong repository name '%s', expected '%s'", enteredRepoSlug, repoSlug)
 		}
 	}
 
-	_, err := client.DeleteRepo(owner, repoName)
+	resp, err := client.DeleteRepo(owner, repoName)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	fmt.Printf("Successfully deleted %s/%s\n", owner, repoName)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -32,8 +33,13 @@ var CmdRepoFork = cli.Command{
 }
 
 func runRepoFork(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	opts := gitea.CreateForkOption{}
@@ -42,14 +48,14 @@ func runRepoFork(cmd *cli.Context) error {
 		opts.Organization = &owner
 	}
 
-	repo, _, err := client.CreateFork(ctx.Owner, ctx.Repo, opts)
+	repo, resp, err := client.CreateFork(ctx.Owner, ctx.Repo, opts)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	topics, _, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
+	topics, resp, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	print.RepoDetails(repo, topics)
 
@@ -4,20 +4,24 @@
 package repos
 
 import (
+	"slices"
+
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
 )
 
-var repoFieldsFlag = flags.FieldsFlag(print.RepoFields, []string{
+var repoFieldsFlag = flags.LoginFieldsFlag(print.RepoFields, []string{
 	"owner", "name", "type", "ssh",
 })
 
 // CmdReposListFlags contains all flags needed for repo listing
-var CmdReposListFlags = append([]cli.Flag{
+var CmdReposListFlags = append(append([]cli.Flag{
 	&cli.BoolFlag{
 		Name:     "watched",
 		Aliases:  []string{"w"},
@@ -34,7 +38,7 @@ var CmdReposListFlags = append([]cli.Flag{
 	&typeFilterFlag,
 	&flags.PaginationPageFlag,
 	&flags.PaginationLimitFlag,
-}, flags.LoginOutputFlags...)
+}, flags.LoginsFlags...), flags.LoginOutputFlags...)
 
 // CmdReposList represents a sub command of repos to list them
 var CmdReposList = cli.Command{
@@ -48,46 +52,68 @@ var CmdReposList = cli.Command{
 
 // RunReposList list repositories
 func RunReposList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	client := ctx.Login.Client()
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 
 	typeFilter, err := getTypeFilter(cmd)
 	if err != nil {
 		return err
 	}
 
-	var rps []*gitea.Repository
-	if ctx.Bool("starred") {
-		user, _, err := client.GetMyUserInfo()
+	list := func(login *config.Login) ([]*gitea.Repository, error) {
+		client := login.Client()
+		var rps []*gitea.Repository
+		var resp *gitea.Response
+		var err error
+		if ctx.Bool("starred") {
+			var user *gitea.User
+			user, resp, err = client.GetMyUserInfo()
+			if err != nil {
+				return nil, config.ClassifyAPIError(resp, err)
+			}
+			rps, resp, err = client.SearchRepos(gitea.SearchRepoOptions{
+				ListOptions:     ctx.GetListOptions(),
+				StarredByUserID: user.ID,
+			})
+		} else if ctx.Bool("watched") {
+			rps, resp, err = client.GetMyWatchedRepos() // TODO: this does not expose pagination..
+		} else {
+			rps, resp, err = client.ListMyRepos(gitea.ListReposOptions{
+				ListOptions: ctx.GetListOptions(),
+			})
+		}
+
 		if err != nil {
-			return err
+			return nil, config.ClassifyAPIError(resp, err)
+		}
+		if typeFilter != gitea.RepoTypeNone {
+			rps = filterReposByType(rps, typeFilter)
 		}
-		rps, _, err = client.SearchRepos(gitea.SearchRepoOptions{
-			ListOptions:     ctx.GetListOptions(),
-			StarredByUserID: user.ID,
-		})
-	} else if ctx.Bool("watched") {
-		rps, _, err = client.GetMyWatchedRepos() // TODO: this does not expose pagination..
-	} else {
-		rps, _, err = client.ListMyRepos(gitea.ListReposOptions{
-			ListOptions: ctx.GetListOptions(),
-		})
+		return rps, nil
 	}
 
+	fields, err := repoFieldsFlag.GetValues(cmd)
 	if err != nil {
 		return err
 	}
 
-	reposFiltered := rps
-	if typeFilter != gitea.RepoTypeNone {
-		reposFiltered = filterReposByType(rps, typeFilter)
+	logins, err := flags.GetLogins(cmd)
+	if err != nil {
+		return err
+	}
+	if logins == nil && slices.Contains(fields, "login") {
+		logins = []*config.Login{ctx.Login}
+	}
+	if logins != nil {
+		return print.ReposListPerLogin(task.ForEachLogin(logins, list), ctx.Output, fields)
 	}
 
-	fields, err := repoFieldsFlag.GetValues(cmd)
+	reposFiltered, err := list(ctx.Login)
 	if err != nil {
 		return err
 	}
-
 	print.ReposList(reposFiltered, ctx.Output, fields)
 	return nil
 }
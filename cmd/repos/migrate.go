@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -108,11 +109,14 @@ var CmdRepoMigrate = cli.Command{
 }
 
 func runRepoMigrate(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 	var (
 		repo    *gitea.Repository
-		err     error
+		resp    *gitea.Response
 		service gitea.GitServiceType
 	)
 
@@ -155,15 +159,15 @@ func runRepoMigrate(cmd *cli.Context) error {
 		LFSEndpoint:    ctx.String("lfs-endpoint"),
 	}
 
-	repo, _, err = client.MigrateRepo(opts)
+	repo, resp, err = client.MigrateRepo(opts)
 
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	topics, _, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
+	topics, resp, err := client.ListRepoTopics(repo.Owner.UserName, repo.Name, gitea.ListRepoTopicsOptions{})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	print.RepoDetails(repo, topics)
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
 
@@ -56,7 +57,10 @@ var CmdReposSearch = cli.Command{
 }
 
 func runReposSearch(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	client := ctx.Login.Client()
 
 	var ownerID int64
@@ -66,13 +70,13 @@ func runReposSearch(cmd *cli.Context) error {
 		if err != nil {
 			// HACK: the client does not return a response on 404, so we can't check res.StatusCode
 			if err.Error() != "404 Not Found" {
-				return fmt.Errorf("Could not find owner: %s", err)
+				return fmt.Errorf("Could not find owner: %w", err)
 			}
 
 			// if owner is no org, its a user
-			user, _, err := client.GetUserInfo(ctx.String("owner"))
+			user, resp, err := client.GetUserInfo(ctx.String("owner"))
 			if err != nil {
-				return err
+				return config.ClassifyAPIError(resp, err)
 			}
 			ownerID = user.ID
 		} else {
@@ -102,12 +106,12 @@ func runReposSearch(cmd *cli.Context) error {
 		keyword = strings.Join(ctx.Args().Slice(), " ")
 	}
 
-	user, _, err := client.GetMyUserInfo()
+	user, resp, err := client.GetMyUserInfo()
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
-	rps, _, err := client.SearchRepos(gitea.SearchRepoOptions{
+	rps, resp, err := client.SearchRepos(gitea.SearchRepoOptions{
 		ListOptions:          ctx.GetListOptions(),
 		OwnerID:              ownerID,
 		IsPrivate:            isPrivate,
@@ -119,7 +123,7 @@ func runReposSearch(cmd *cli.Context) error {
 		PrioritizedByOwnerID: user.ID,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	fields, err := repoFieldsFlag.GetValues(cmd)
@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"code.gitea.io/tea/cmd/search"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdSearch is the main command to manage saved searches
+var CmdSearch = cli.Command{
+	Name:     "search",
+	Category: catHelpers,
+	Usage:    "Search issues and pull requests, and manage saved searches",
+	Description: `Search issues and pull requests across all repositories with 'tea search issues|pulls',
+and code with 'tea search code'.
+
+Save the flags of complex 'tea issues' or 'tea pulls' invocations as named search,
+and run them again later. Lists the saved searches when called without argument.`,
+	Action: search.RunSearchList,
+	Subcommands: []*cli.Command{
+		&search.CmdSearchIssues,
+		&search.CmdSearchPulls,
+		&search.CmdSearchCode,
+		&search.CmdSearchList,
+		&search.CmdSearchSave,
+		&search.CmdSearchRun,
+		&search.CmdSearchDelete,
+	},
+	Flags: search.CmdSearchList.Flags,
+}
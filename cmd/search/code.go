@@ -0,0 +1,101 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/skratchdot/open-golang/open"
+	"github.com/urfave/cli/v2"
+)
+
+var codeFieldsFlag = flags.FieldsFlag(print.CodeSearchFields, []string{
+	"repo", "path", "line", "snippet",
+})
+
+// CmdSearchCode searches code
+var CmdSearchCode = cli.Command{
+	Name:  "code",
+	Usage: "Search code in repositories",
+	Description: `Search code of a repository, of all repositories of a user or organization
+with --owner, or of all repositories with --global, and list the matching lines.
+
+The Gitea API provides no code search, so the results of the code search of
+the web interface are listed. That search is done without the login
+credentials, so private repositories are not covered. Searching beyond a
+repository, and fuzzy matches, require the code indexer to be enabled on the
+instance.
+
+	tea search code "func main"
+	tea search code --owner my-org --fields repo,path,line,url TODO
+	tea search code --open "func main"   # open the best hit in the web browser`,
+	ArgsUsage: "<query>",
+	Action:    runSearchCode,
+	Flags: append([]cli.Flag{
+		codeFieldsFlag,
+		&cli.StringFlag{
+			Name:    "owner",
+			Aliases: []string{"org"},
+			Usage:   "Search the repositories of a user or organization",
+		},
+		&cli.BoolFlag{
+			Name:  "global",
+			Usage: "Search all repositories of the instance",
+		},
+		&cli.BoolFlag{
+			Name:  "open",
+			Usage: "Open the first hit in the web browser, or the search if there is none",
+		},
+		&flags.PaginationPageFlag,
+	}, flags.AllDefaultFlags...),
+}
+
+func runSearchCode(cmd *cli.Context) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if !ctx.Args().Present() {
+		return utils.NewInvalidArgumentErrorf("Must specify a query")
+	}
+	if ctx.IsSet("owner") && ctx.Bool("global") {
+		return utils.NewInvalidArgumentErrorf("--owner and --global can't be combined")
+	}
+	fields, err := codeFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := task.SearchCodeOption{
+		Query: strings.Join(ctx.Args().Slice(), " "),
+		Owner: ctx.String("owner"),
+		Page:  ctx.Int("page"),
+	}
+	if !ctx.Bool("global") && opts.Owner == "" {
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+			return fmt.Errorf("%w\nUse --owner or --global to search beyond a repository", err)
+		}
+		opts.Owner, opts.Repo = ctx.Owner, ctx.Repo
+	}
+
+	hits, err := task.SearchCode(ctx.Login, opts)
+	if err != nil {
+		return err
+	}
+	if ctx.Bool("open") {
+		if len(hits) == 0 {
+			return open.Run(task.CodeSearchURL(ctx.Login, opts))
+		}
+		return open.Run(hits[0].URL)
+	}
+	print.CodeSearchHitsList(hits, ctx.Output, fields)
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"strings"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdSearchDelete deletes saved searches
+var CmdSearchDelete = cli.Command{
+	Name:      "delete",
+	Aliases:   []string{"rm"},
+	Usage:     "Delete saved searches",
+	ArgsUsage: "<name> [<name>...]",
+	Action: func(ctx *cli.Context) error {
+		if !ctx.Args().Present() {
+			return utils.NewInvalidArgumentErrorf("Must specify the name of a saved search")
+		}
+		for _, name := range ctx.Args().Slice() {
+			if err := config.DeleteSearch(strings.TrimPrefix(name, "@"), ctx.Bool("shared")); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "shared",
+			Usage: "Delete the search from the repo config file " + config.RepoConfigFile,
+		},
+	},
+}
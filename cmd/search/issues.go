@@ -0,0 +1,200 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"strings"
+	"time"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/araddon/dateparse"
+	"github.com/urfave/cli/v2"
+)
+
+var searchFieldsFlag = flags.FieldsFlag(print.IssueFields, []string{
+	"slug", "index", "title", "state", "author", "labels", "updated",
+})
+
+var searchIssuesFlags = append([]cli.Flag{
+	searchFieldsFlag,
+	&flags.StateFlag,
+	&cli.StringFlag{
+		Name:    "keyword",
+		Aliases: []string{"k"},
+		Usage:   "Filter by search string",
+	},
+	flags.LabelFilterFlag,
+	flags.MilestoneFilterFlag,
+	&cli.StringFlag{
+		Name:    "author",
+		Aliases: []string{"A"},
+		Usage:   "Filter by the user who created the issue, '@me' for the login user. Other users require --owner",
+	},
+	&cli.StringFlag{
+		Name:    "assignee",
+		Aliases: []string{"a"},
+		Usage:   "Filter by assigned user, '@me' for the login user. Other users require --owner",
+	},
+	&cli.StringFlag{
+		Name:    "mentions",
+		Aliases: []string{"M"},
+		Usage:   "Filter by mentioned user. Only the login user is supported, as '@me'",
+	},
+	&cli.StringFlag{
+		Name:  "review-requested",
+		Usage: "Filter by user whose review is requested. Only the login user is supported, as '@me'",
+	},
+	&cli.StringFlag{
+		Name:    "owner",
+		Aliases: []string{"org"},
+		Usage:   "Filter by owner of the repository, user or organization",
+	},
+	&cli.StringFlag{
+		Name:  "team",
+		Usage: "Filter by team of the organization given with --owner",
+	},
+	&cli.StringFlag{
+		Name:    "from",
+		Aliases: []string{"F"},
+		Usage:   "Filter by activity after this date",
+	},
+	&cli.StringFlag{
+		Name:    "until",
+		Aliases: []string{"u"},
+		Usage:   "Filter by activity before this date",
+	},
+	&cli.IntFlag{
+		Name:    "limit",
+		Aliases: []string{"lm"},
+		Usage:   "Maximum number of results, all are fetched if unset",
+	},
+}, flags.LoginOutputFlags...)
+
+// CmdSearchIssues searches issues across repositories
+var CmdSearchIssues = cli.Command{
+	Name:    "issues",
+	Aliases: []string{"issue", "i"},
+	Usage:   "Search issues in all repositories",
+	Description: `Search issues in all repositories accessible to the login user, eg.
+
+	tea search issues --assignee @me --labels bug --state open
+	tea search issues --owner my-org --team backend --from "last monday"`,
+	ArgsUsage: "[<keyword>]",
+	Action: func(ctx *cli.Context) error {
+		return runSearchIssues(ctx, gitea.IssueTypeIssue)
+	},
+	Flags: searchIssuesFlags,
+}
+
+// CmdSearchPulls searches pull requests across repositories
+var CmdSearchPulls = cli.Command{
+	Name:    "pulls",
+	Aliases: []string{"pull", "pr"},
+	Usage:   "Search pull requests in all repositories",
+	Description: `Search pull requests in all repositories accessible to the login user, eg.
+
+	tea search pulls --review-requested @me`,
+	ArgsUsage: "[<keyword>]",
+	Action: func(ctx *cli.Context) error {
+		return runSearchIssues(ctx, gitea.IssueTypePull)
+	},
+	Flags: searchIssuesFlags,
+}
+
+func runSearchIssues(cmd *cli.Context, kind gitea.IssueType) error {
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := task.SearchIssuesOption{
+		Type:     kind,
+		KeyWord:  ctx.String("keyword"),
+		Owner:    ctx.String("owner"),
+		Team:     ctx.String("team"),
+		Author:   searchUser(ctx, "author"),
+		Assignee: searchUser(ctx, "assignee"),
+		Limit:    ctx.Int("limit"),
+	}
+	if ctx.Args().Present() {
+		if opts.KeyWord != "" {
+			return utils.NewInvalidArgumentErrorf("specify the keyword either as argument or with --keyword")
+		}
+		opts.KeyWord = strings.Join(ctx.Args().Slice(), " ")
+	}
+	if opts.Team != "" && opts.Owner == "" {
+		return utils.NewInvalidArgumentErrorf("--team requires the organization to be given with --owner")
+	}
+	// the API only filters by the login user, others are filtered client side,
+	// which must not page through all issues accessible to the login user
+	for flag, user := range map[string]string{"author": opts.Author, "assignee": opts.Assignee} {
+		if user != "" && !strings.EqualFold(user, ctx.Login.User) && opts.Owner == "" {
+			return utils.NewInvalidArgumentErrorf("--%s of users other than '@me' requires --owner to limit the search", flag)
+		}
+	}
+	if opts.Mentioned, err = isLoginUser(ctx, "mentions"); err != nil {
+		return err
+	}
+	if opts.ReviewRequested, err = isLoginUser(ctx, "review-requested"); err != nil {
+		return err
+	}
+	if opts.State, err = flags.ParseState(ctx.String("state")); err != nil {
+		return err
+	}
+	for flag, t := range map[string]*time.Time{"from": &opts.Since, "until": &opts.Before} {
+		if ctx.IsSet(flag) {
+			if *t, err = dateparse.ParseLocal(ctx.String(flag)); err != nil {
+				return utils.NewInvalidArgumentErrorf("invalid --%s: %v", flag, err)
+			}
+		}
+	}
+	// ignore error, as we don't do any input validation on these flags
+	opts.Labels, _ = flags.LabelFilterFlag.GetValues(cmd)
+	opts.Milestones, _ = flags.MilestoneFilterFlag.GetValues(cmd)
+	if len(opts.Labels) == 1 && opts.Labels[0] == "" {
+		opts.Labels = nil
+	}
+	if len(opts.Milestones) == 1 && opts.Milestones[0] == "" {
+		opts.Milestones = nil
+	}
+
+	fields, err := searchFieldsFlag.GetValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	issues, err := task.SearchIssues(ctx.Login, opts)
+	if err != nil {
+		return err
+	}
+	print.IssuesPullsList(issues, ctx.Output, fields)
+	return nil
+}
+
+// searchUser returns the username given with flag, resolving '@me'
+func searchUser(ctx *context.TeaContext, flag string) string {
+	if user := ctx.String(flag); user != "@me" {
+		return user
+	}
+	return ctx.Login.User
+}
+
+// isLoginUser reports whether flag is set to the login user, and fails if it
+// is set to another user, which the API can't filter by.
+func isLoginUser(ctx *context.TeaContext, flag string) (bool, error) {
+	user := searchUser(ctx, flag)
+	if user == "" {
+		return false, nil
+	}
+	if !strings.EqualFold(user, ctx.Login.User) {
+		return false, utils.NewInvalidArgumentErrorf("--%s only supports the login user, '@me'", flag)
+	}
+	return true, nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdSearchList lists saved searches
+var CmdSearchList = cli.Command{
+	Name:        "list",
+	Aliases:     []string{"ls"},
+	Usage:       "List saved searches",
+	Description: "List the searches saved in the config file, and shared in the repo config file",
+	Action:      RunSearchList,
+	Flags:       []cli.Flag{&flags.OutputFlag},
+}
+
+// RunSearchList lists saved searches
+func RunSearchList(ctx *cli.Context) error {
+	searches, err := config.GetSavedSearches()
+	if err != nil {
+		return err
+	}
+	print.SavedSearchesList(searches, ctx.String("output"))
+	return nil
+}
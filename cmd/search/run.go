@@ -0,0 +1,117 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/cmd/issues"
+	"code.gitea.io/tea/cmd/pulls"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// listCommands are the commands which saved searches can be run with
+var listCommands = map[string]*cli.Command{
+	"issues": &issues.CmdIssuesList,
+	"pulls":  &pulls.CmdPullsList,
+}
+
+// CmdSearchRun runs a saved search
+var CmdSearchRun = cli.Command{
+	Name:    "run",
+	Aliases: []string{"r"},
+	Usage:   "Run a saved search",
+	Description: `Run a saved search. Further flags of the list command may be given after the
+name, and take precedence over the saved ones:
+
+	tea search run daily --state closed
+
+Saved searches can also be run as 'tea issues @<name>' or 'tea pulls @<name>'.`,
+	ArgsUsage: "<name> [<flags>...]",
+	Action: func(ctx *cli.Context) error {
+		if !ctx.Args().Present() {
+			return utils.NewInvalidArgumentErrorf("Must specify the name of a saved search")
+		}
+		return RunSavedSearch(ctx, ctx.Args().First(), "", ctx.Args().Tail())
+	},
+	Flags: flags.AllDefaultFlags,
+}
+
+// RunSavedSearch runs the saved search with the given name. command is the
+// command the search must have been saved for, or empty to accept any.
+// Flags in extraArgs, or set on cmd, take precedence over the saved ones.
+func RunSavedSearch(cmd *cli.Context, name, command string, extraArgs []string) error {
+	search, err := config.GetSavedSearch(strings.TrimPrefix(name, "@"))
+	if err != nil {
+		return err
+	}
+	if command != "" && search.Command != command {
+		return utils.NewInvalidArgumentErrorf("saved search '%s' lists %s, run it with 'tea %s @%s'",
+			search.Name, search.Command, search.Command, search.Name)
+	}
+	listCmd, ok := listCommands[search.Command]
+	if !ok {
+		return fmt.Errorf("saved search '%s' has unknown command '%s'", search.Name, search.Command)
+	}
+
+	set, err := parseSearchArgs(listCmd, search.Args)
+	if err != nil {
+		return fmt.Errorf("invalid saved search '%s': %w", search.Name, err)
+	}
+	if err = set.Parse(extraArgs); err != nil {
+		return utils.NewInvalidArgumentErrorf("%v", err)
+	} else if set.NArg() != 0 {
+		return utils.NewInvalidArgumentErrorf("unexpected argument '%s'", set.Arg(0))
+	}
+
+	// flags set before the search name are not part of extraArgs, but still
+	// override the saved ones
+	seen := make(map[flag.Value]bool)
+	for _, name := range cmd.LocalFlagNames() {
+		f := set.Lookup(name)
+		if f == nil || seen[f.Value] {
+			continue
+		}
+		seen[f.Value] = true
+		values := []string{fmt.Sprint(cmd.Value(name))}
+		if slice, ok := cmd.Value(name).(*cli.StringSlice); ok {
+			values = slice.Value()
+		}
+		for _, v := range values {
+			if err := set.Set(name, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	listCtx := cli.NewContext(cmd.App, set, cmd)
+	listCtx.Command = listCmd
+	return listCmd.Action(listCtx)
+}
+
+// parseSearchArgs parses args with the flags of listCmd. Positional
+// arguments are rejected, as they'd show a single issue instead of a list.
+func parseSearchArgs(listCmd *cli.Command, args []string) (*flag.FlagSet, error) {
+	set := flag.NewFlagSet(listCmd.Name, flag.ContinueOnError)
+	set.SetOutput(io.Discard)
+	for _, f := range listCmd.Flags {
+		if err := f.Apply(set); err != nil {
+			return nil, err
+		}
+	}
+	if err := set.Parse(args); err != nil {
+		return nil, err
+	}
+	if set.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected argument '%s', only flags can be saved", set.Arg(0))
+	}
+	return set, nil
+}
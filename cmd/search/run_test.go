@@ -0,0 +1,37 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"testing"
+
+	"code.gitea.io/tea/cmd/issues"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "no args", args: nil},
+		{name: "flags", args: []string{"--state", "closed", "--labels", "bug"}},
+		{name: "positional", args: []string{"42"}, wantErr: "unexpected argument '42', only flags can be saved"},
+		{name: "positional after flags", args: []string{"--state", "all", "42"}, wantErr: "unexpected argument '42', only flags can be saved"},
+		{name: "unknown flag", args: []string{"--bogus"}, wantErr: "flag provided but not defined: -bogus"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := parseSearchArgs(&issues.CmdIssuesList, tt.args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 0, set.NArg())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/urfave/cli/v2"
+)
+
+// CmdSearchSave saves a search
+var CmdSearchSave = cli.Command{
+	Name:    "save",
+	Aliases: []string{"s"},
+	Usage:   "Save flags of 'tea issues' or 'tea pulls' as named search",
+	Description: `Save flags of 'tea issues list' or 'tea pulls list', including output
+settings, as named search. The flags follow the name, optionally after --:
+
+	tea search save daily -- --labels bug --assignee @me --fields index,title,milestone
+	tea search save --pulls review-queue --state open --output simple
+
+Searches are saved in the config file, or with --shared in the file ` + config.RepoConfigFile + `
+in the root of the local repository, to be committed and shared with others.
+Saving a search with an existing name replaces it.`,
+	ArgsUsage: "<name> [--] <flags>...",
+	Action:    runSearchSave,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "pulls",
+			Usage: "Save a search for pull requests instead of issues",
+		},
+		&cli.BoolFlag{
+			Name:  "shared",
+			Usage: "Save the search in the repo config file " + config.RepoConfigFile,
+		},
+	},
+}
+
+func runSearchSave(ctx *cli.Context) error {
+	if !ctx.Args().Present() {
+		return utils.NewInvalidArgumentErrorf("Must specify a name for the search")
+	}
+	name := strings.TrimPrefix(ctx.Args().First(), "@")
+	if name == "" || strings.ContainsAny(name, " \t\n") {
+		return utils.NewInvalidArgumentErrorf("invalid search name '%s'", ctx.Args().First())
+	}
+	args := ctx.Args().Tail()
+	if len(args) != 0 && args[0] == "--" {
+		args = args[1:]
+	}
+
+	search := config.SavedSearch{
+		Name:    name,
+		Command: "issues",
+		Args:    args,
+		Shared:  ctx.Bool("shared"),
+	}
+	if ctx.Bool("pulls") {
+		search.Command = "pulls"
+	}
+	if _, err := parseSearchArgs(listCommands[search.Command], args); err != nil {
+		return utils.NewInvalidArgumentErrorf("%v", err)
+	}
+	if err := config.SaveSearch(search); err != nil {
+		return err
+	}
+	if !utils.DryRun() {
+		fmt.Printf("Saved search '%s', run it with 'tea %s @%s'\n", name, search.Command, name)
+	}
+	return nil
+}
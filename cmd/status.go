@@ -0,0 +1,84 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"sync"
+	"time"
+
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/araddon/dateparse"
+	"github.com/urfave/cli/v2"
+)
+
+// CmdStatus represents the command to show what needs the login user
+var CmdStatus = cli.Command{
+	Name:     "status",
+	Category: catHelpers,
+	Usage:    "Show what needs your attention across all repositories",
+	Description: `Show pull requests awaiting your review, your open pull requests with their
+CI and review state, issues assigned to you, recent mentions and unread notifications.
+
+With --all-logins or --logins, the status of several logins is shown.`,
+	ArgsUsage: " ", // command does not accept arguments
+	Action:    runStatus,
+	Flags: append(append([]cli.Flag{
+		&cli.StringFlag{
+			Name:    "owner",
+			Aliases: []string{"org"},
+			Usage:   "Only show items of repositories of this user or organization",
+		},
+		&cli.StringFlag{
+			Name:        "since",
+			Aliases:     []string{"s"},
+			Usage:       "Only show mentions updated after the given time",
+			DefaultText: "2 weeks ago",
+		},
+	}, flags.LoginsFlags...), flags.LoginOutputFlags...),
+}
+
+func runStatus(cmd *cli.Context) error {
+	if output := cmd.String("output"); output != "" && output != "json" {
+		return utils.NewInvalidArgumentErrorf("unknown output type '%s', available types are markdown (default) and json", output)
+	}
+
+	since := time.Now().AddDate(0, 0, -14)
+	if cmd.IsSet("since") {
+		var err error
+		if since, err = dateparse.ParseLocal(cmd.String("since")); err != nil {
+			return utils.NewInvalidArgumentErrorf("invalid --since: %v", err)
+		}
+	}
+
+	logins, err := flags.GetLogins(cmd)
+	if err != nil {
+		return err
+	}
+	if logins == nil {
+		ctx, err := context.InitCommand(cmd)
+		if err != nil {
+			return err
+		}
+		logins = []*config.Login{ctx.Login}
+	}
+
+	statuses := make([]*print.UserStatus, len(logins))
+	var wg sync.WaitGroup
+	for i, login := range logins {
+		wg.Add(1)
+		go func(i int, login *config.Login) {
+			defer wg.Done()
+			statuses[i] = task.GetUserStatus(login, cmd.String("owner"), since)
+		}(i, login)
+	}
+	wg.Wait()
+
+	return print.Status(statuses, cmd.String("output"))
+}
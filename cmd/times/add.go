@@ -9,8 +9,9 @@ import (
 	"time"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
-	"code.gitea.io/tea/modules/utils"
+	"code.gitea.io/tea/modules/interact"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/urfave/cli/v2"
@@ -31,25 +32,39 @@ var CmdTrackedTimesAdd = cli.Command{
 }
 
 func runTrackedTimesAdd(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-
-	if ctx.Args().Len() < 2 {
-		return fmt.Errorf("No issue or duration specified.\nUsage:\t%s", ctx.Command.UsageText)
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if ctx.Args().Len() == 0 {
+		return fmt.Errorf("No duration specified.\nUsage:\t%s", ctx.Command.UsageText)
 	}
 
-	issue, err := utils.ArgToIndex(ctx.Args().First())
-	if err != nil {
+	// when only a duration is given, select the issue interactively
+	durationArgs := ctx.Args().Tail()
+	var issue int64
+	if ctx.Args().Len() > 1 {
+		if issue, err = ctx.ArgToIndex(ctx.Args().First()); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
 		return err
 	}
+	if ctx.Args().Len() == 1 {
+		durationArgs = ctx.Args().Slice()
+		if issue, err = interact.SelectIssue(ctx, gitea.IssueTypeAll, gitea.StateOpen); err != nil {
+			return err
+		}
+	}
 
-	duration, err := time.ParseDuration(strings.Join(ctx.Args().Tail(), ""))
+	duration, err := time.ParseDuration(strings.Join(durationArgs, ""))
 	if err != nil {
 		return err
 	}
 
-	_, _, err = ctx.Login.Client().AddTime(ctx.Owner, ctx.Repo, issue, gitea.AddTimeOption{
+	_, resp, err := ctx.Login.Client().AddTime(ctx.Owner, ctx.Repo, issue, gitea.AddTimeOption{
 		Time: int64(duration.Seconds()),
 	})
-	return err
+	return config.ClassifyAPIError(resp, err)
 }
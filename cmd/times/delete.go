@@ -8,8 +8,8 @@ import (
 	"strconv"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
-	"code.gitea.io/tea/modules/utils"
 
 	"github.com/urfave/cli/v2"
 )
@@ -25,24 +25,28 @@ var CmdTrackedTimesDelete = cli.Command{
 }
 
 func runTrackedTimesDelete(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
-
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() < 2 {
 		return fmt.Errorf("No issue or time ID specified.\nUsage:\t%s", ctx.Command.UsageText)
 	}
 
-	issue, err := utils.ArgToIndex(ctx.Args().First())
+	issue, err := ctx.ArgToIndex(ctx.Args().First())
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
 
 	timeID, err := strconv.ParseInt(ctx.Args().Get(1), 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.DeleteTime(ctx.Owner, ctx.Repo, issue, timeID)
-	return err
+	resp, err := client.DeleteTime(ctx.Owner, ctx.Repo, issue, timeID)
+	return config.ClassifyAPIError(resp, err)
 }
@@ -5,12 +5,15 @@ package times
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/task"
 	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
@@ -18,14 +21,17 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// timeFields are the fields of tracked times, and the login field of --all-logins
+var timeFields = append(slices.Clip(print.TrackedTimeFields), "login")
+
 // NOTE: not using NewCsvFlag, as we don't want an alias & default value.
 var timeFieldsFlag = &flags.CsvFlag{
-	AvailableFields: print.TrackedTimeFields,
+	AvailableFields: timeFields,
 	StringFlag: cli.StringFlag{
 		Name: "fields",
 		Usage: fmt.Sprintf(`Comma-separated list of fields to print. Available values:
 	%s
-`, strings.Join(print.TrackedTimeFields, ",")),
+`, strings.Join(timeFields, ",")),
 	},
 }
 
@@ -37,12 +43,12 @@ var CmdTrackedTimesList = cli.Command{
 	Usage:   "List tracked times on issues & pulls",
 	Description: `List tracked times, across repos, or on a single repo or issue:
 - given a username all times on a repo by that user are shown,
-- given a issue index with '#' prefix, all times on that issue are listed,
+- given a issue index with '#' prefix, as owner/repo#index or URL, all times on that issue are listed,
 - given --mine, your times are listed across all repositories.
 Depending on your permissions on the repository, only your own tracked times might be listed.`,
-	ArgsUsage: "[username | #issue]",
+	ArgsUsage: "[username | #issue | owner/repo#issue | issue URL]",
 
-	Flags: append([]cli.Flag{
+	Flags: append(append([]cli.Flag{
 		&cli.StringFlag{
 			Name:    "from",
 			Aliases: []string{"f"},
@@ -64,17 +70,36 @@ Depending on your permissions on the repository, only your own tracked times mig
 			Usage:   "Show all times tracked by you across all repositories (overrides command arguments)",
 		},
 		timeFieldsFlag,
-	}, flags.AllDefaultFlags...),
+	}, flags.LoginsFlags...), flags.AllDefaultFlags...),
 }
 
 // RunTimesList list repositories
 func RunTimesList(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
+	logins, err := flags.GetLogins(cmd)
+	if err != nil {
+		return err
+	}
+	// an issue may be given as #index, owner/repo#index or URL
+	user := ctx.Args().First()
+	var issue int64
+	if !ctx.Bool("mine") && (strings.Contains(user, "#") || strings.HasPrefix(user, "http://") || strings.HasPrefix(user, "https://")) {
+		if issue, err = ctx.ArgToIndex(user); err != nil {
+			return err
+		}
+	}
+	if !ctx.Bool("mine") {
+		if logins != nil {
+			return utils.NewInvalidArgumentErrorf("--all-logins and --logins require --mine, as repositories differ between logins")
+		}
+		if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+			return err
+		}
+	}
 
-	var times []*gitea.TrackedTime
-	var err error
 	var from, until time.Time
 	var fields []string
 
@@ -93,39 +118,56 @@ func RunTimesList(cmd *cli.Context) error {
 
 	opts := gitea.ListTrackedTimesOptions{Since: from, Before: until}
 
-	user := ctx.Args().First()
+	var list func(client *gitea.Client) ([]*gitea.TrackedTime, *gitea.Response, error)
 	if ctx.Bool("mine") {
-		times, _, err = client.GetMyTrackedTimes()
+		list = func(client *gitea.Client) ([]*gitea.TrackedTime, *gitea.Response, error) {
+			return client.GetMyTrackedTimes()
+		}
 		fields = []string{"created", "repo", "issue", "duration"}
 	} else if user == "" {
 		// get all tracked times on the repo
-		times, _, err = client.ListRepoTrackedTimes(ctx.Owner, ctx.Repo, opts)
+		list = func(client *gitea.Client) ([]*gitea.TrackedTime, *gitea.Response, error) {
+			return client.ListRepoTrackedTimes(ctx.Owner, ctx.Repo, opts)
+		}
 		fields = []string{"created", "issue", "user", "duration"}
-	} else if strings.HasPrefix(user, "#") {
+	} else if issue != 0 {
 		// get all tracked times on the specified issue
-		issue, err := utils.ArgToIndex(user)
-		if err != nil {
-			return err
+		list = func(client *gitea.Client) ([]*gitea.TrackedTime, *gitea.Response, error) {
+			return client.ListIssueTrackedTimes(ctx.Owner, ctx.Repo, issue, opts)
 		}
-		times, _, err = client.ListIssueTrackedTimes(ctx.Owner, ctx.Repo, issue, opts)
 		fields = []string{"created", "user", "duration"}
 	} else {
 		// get all tracked times by the specified user
 		opts.User = user
-		times, _, err = client.ListRepoTrackedTimes(ctx.Owner, ctx.Repo, opts)
+		list = func(client *gitea.Client) ([]*gitea.TrackedTime, *gitea.Response, error) {
+			return client.ListRepoTrackedTimes(ctx.Owner, ctx.Repo, opts)
+		}
 		fields = []string{"created", "issue", "duration"}
 	}
 
-	if err != nil {
-		return err
-	}
-
 	if ctx.IsSet("fields") {
 		if fields, err = timeFieldsFlag.GetValues(cmd); err != nil {
 			return err
 		}
 	}
 
+	if logins == nil && !ctx.Bool("total") && slices.Contains(fields, "login") {
+		logins = []*config.Login{ctx.Login}
+	}
+	if logins != nil {
+		if ctx.Bool("total") {
+			return utils.NewInvalidArgumentErrorf("--total can't be combined with --all-logins or --logins")
+		}
+		return print.TrackedTimesListPerLogin(task.ForEachLogin(logins, func(login *config.Login) ([]*gitea.TrackedTime, error) {
+			times, _, err := list(login.Client())
+			return times, err
+		}), ctx.Output, fields)
+	}
+
+	times, _, err := list(ctx.Login.Client())
+	if err != nil {
+		return err
+	}
 	print.TrackedTimesList(times, ctx.Output, fields, ctx.Bool("total"))
 	return nil
 }
@@ -7,8 +7,8 @@ import (
 	"fmt"
 
 	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
-	"code.gitea.io/tea/modules/utils"
 
 	"github.com/urfave/cli/v2"
 )
@@ -24,19 +24,23 @@ var CmdTrackedTimesReset = cli.Command{
 }
 
 func runTrackedTimesReset(cmd *cli.Context) error {
-	ctx := context.InitCommand(cmd)
-	ctx.Ensure(context.CtxRequirement{RemoteRepo: true})
-	client := ctx.Login.Client()
-
+	ctx, err := context.InitCommand(cmd)
+	if err != nil {
+		return err
+	}
 	if ctx.Args().Len() != 1 {
 		return fmt.Errorf("No issue specified.\nUsage:\t%s", ctx.Command.UsageText)
 	}
 
-	issue, err := utils.ArgToIndex(ctx.Args().First())
+	issue, err := ctx.ArgToIndex(ctx.Args().First())
 	if err != nil {
 		return err
 	}
+	if err := ctx.Ensure(context.CtxRequirement{RemoteRepo: true}); err != nil {
+		return err
+	}
+	client := ctx.Login.Client()
 
-	_, err = client.ResetIssueTime(ctx.Owner, ctx.Repo, issue)
-	return err
+	resp, err := client.ResetIssueTime(ctx.Owner, ctx.Repo, issue)
+	return config.ClassifyAPIError(resp, err)
 }
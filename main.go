@@ -5,12 +5,15 @@
 package main // import "code.gitea.io/tea"
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"runtime"
 	"strings"
 
 	"code.gitea.io/tea/cmd"
+	"code.gitea.io/tea/cmd/flags"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/urfave/cli/v2"
 )
@@ -50,24 +53,55 @@ func main() {
 		&cmd.CmdRepos,
 		&cmd.CmdBranches,
 		&cmd.CmdAddComment,
+		&cmd.CmdComments,
+		&cmd.CmdReact,
 
+		&cmd.CmdStatus,
 		&cmd.CmdOpen,
 		&cmd.CmdNotifications,
+		&cmd.CmdSearch,
 		&cmd.CmdRepoClone,
 
 		&cmd.CmdAdmin,
 		&cmd.CmdDocs,
 	}
+	app.Flags = flags.GlobalFlags
+	app.Before = flags.ApplyGlobalFlags
 	app.EnableBashCompletion = true
+	app.ExitErrHandler = func(ctx *cli.Context, err error) {
+		if err != nil {
+			exitWithError(ctx.String("output"), err)
+		}
+	}
 	err := app.Run(os.Args)
 	if err != nil {
-		// app.Run already exits for errors implementing ErrorCoder,
-		// so we only handle generic errors with code 1 here.
-		fmt.Fprintf(app.ErrWriter, "Error: %v\n", err)
-		os.Exit(1)
+		// errors returned by commands are handled by ExitErrHandler already,
+		// so remaining ones stem from parsing the command line.
+		exitWithError("", utils.SilentWrap{Message: err.Error(), Err: utils.ErrInvalidArgument})
 	}
 }
 
+// exitWithError prints err to stderr, formatted as JSON if requested by the
+// output flag, and exits with the code that matches the class of err.
+func exitWithError(output string, err error) {
+	if utils.IsDryRun(err) {
+		// the command stopped at a planned mutation, which is no failure
+		os.Exit(0)
+	}
+	kind, code := utils.ErrorKind(err)
+	if output == "json" {
+		out, _ := json.Marshal(struct {
+			Error string `json:"error"`
+			Kind  string `json:"kind"`
+			Code  int    `json:"code"`
+		}{err.Error(), kind, code})
+		fmt.Fprintln(os.Stderr, string(out))
+	} else {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+	os.Exit(code)
+}
+
 func formatVersion() string {
 	version := fmt.Sprintf("Version: %s\tgolang: %s",
 		bold(Version),
@@ -123,13 +157,29 @@ var helpTemplate = bold(`
    tea pulls --repo gitea/tea --login gitea.com
 
    tea milestone issues 0.7.0          # view open issues for milestone '0.7.0'
+                                       # (milestones are given by name, not URL)
    tea issue 189                       # view contents of issue 189
+   tea issue gitea/tea#189             # view contents of issue 189 in gitea/tea
+   # close issues by their web URL, using the login matching the URL's host
+   tea issues close https://gitea.com/gitea/tea/issues/189
    tea open 189                        # open web ui for issue 189
    tea open milestones                 # open web ui for milestones
 
+   # merge a PR from CI, failing instead of prompting for missing values
+   TEA_NO_INPUT=1 tea pulls merge --style squash 42
+   tea --yes repos delete --name foo   # delete a repo without confirmation
+   tea --dry-run issues close 1 2 3    # print the API requests instead of closing
+
    # send gitea desktop notifications every 5 minutes (bash + libnotify)
    while :; do tea notifications --mine -o simple | xargs -i notify-send {}; sleep 300; done
 
+ EXIT CODES
+   0    success                    4    authentication failed
+   1    unclassified error         5    permission denied
+   2    invalid arguments / usage  6    conflict, resource already exists
+   3    resource not found         7    network error
+                                   130  aborted by user
+
  ABOUT
    Written & maintained by The Gitea Authors.
    If you find a bug or want to contribute, we'll welcome you at https://gitea.com/gitea/tea.
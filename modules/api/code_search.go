@@ -0,0 +1,16 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package api
+
+// CodeSearchHit is a line of code matching a code search
+type CodeSearchHit struct {
+	// Repo is the full name (owner/repo) of the repository of the hit
+	Repo    string
+	Path    string
+	Line    int
+	Content string
+	// Highlights are the [start, end) byte offsets of matches in Content
+	Highlights [][2]int
+	URL        string
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package api holds types of responses of the gitea server the SDK does
+// not provide yet.
+package api
+
+import (
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// TimelineEvent is an entry of the timeline of an issue or pull request,
+// as returned by the issue timeline API.
+type TimelineEvent struct {
+	ID              int64            `json:"id"`
+	Type            string           `json:"type"`
+	HTMLURL         string           `json:"html_url"`
+	User            *gitea.User      `json:"user"`
+	Body            string           `json:"body"`
+	Created         time.Time        `json:"created_at"`
+	OldMilestone    *gitea.Milestone `json:"old_milestone"`
+	Milestone       *gitea.Milestone `json:"milestone"`
+	OldTitle        string           `json:"old_title"`
+	NewTitle        string           `json:"new_title"`
+	OldRef          string           `json:"old_ref"`
+	NewRef          string           `json:"new_ref"`
+	RefIssue        *gitea.Issue     `json:"ref_issue"`
+	RefCommitSHA    string           `json:"ref_commit_sha"`
+	Label           *gitea.Label     `json:"label"`
+	Assignee        *gitea.User      `json:"assignee"`
+	AssigneeTeam    *gitea.Team      `json:"assignee_team"`
+	RemovedAssignee bool             `json:"removed_assignee"`
+	DependentIssue  *gitea.Issue     `json:"dependent_issue"`
+}
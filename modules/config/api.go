@@ -0,0 +1,192 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/utils"
+)
+
+// APIRequest sends a request to an API endpoint of l that is not covered by
+// the sdk yet. path is relative to /api/v1. body is sent as JSON if not nil,
+// and the response is decoded into result if not nil. If result is a *[]byte,
+// the raw response body is stored instead.
+// The returned response provides the pagination links of list endpoints.
+func (l *Login) APIRequest(method, path string, body, result interface{}) (*gitea.Response, error) {
+	var reqBody io.Reader
+	contentType := ""
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	return l.apiRequest(method, path, contentType, reqBody, result)
+}
+
+// APIUpload uploads content as a multipart form with a single file field
+// to an API endpoint of l, like APIRequest.
+func (l *Login) APIUpload(path, field, fileName string, content io.Reader, result interface{}) error {
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile(field, fileName)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(part, content); err != nil {
+		return err
+	}
+	if err = form.Close(); err != nil {
+		return err
+	}
+	_, err = l.apiRequest(http.MethodPost, path, form.FormDataContentType(), &buf, result)
+	return err
+}
+
+// Download fetches a file from the server of l, eg. an attachment, and
+// writes it to w.
+func (l *Login) Download(fileURL string, w io.Writer) error {
+	req, err := l.newRequest(http.MethodGet, fileURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := l.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return utils.ErrorFromStatus(resp.StatusCode, fmt.Errorf("could not download %s: %s", fileURL, resp.Status))
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// newRequest creates a request to the server of l, authenticated the same way
+// as requests of the sdk client of l: by token, and by signing it with the
+// ssh key of l if configured.
+func (l *Login) newRequest(method, rawURL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.Token) != 0 {
+		req.Header.Set("Authorization", "token "+l.Token)
+	}
+	if l.SSHCertPrincipal != "" || l.SSHKeyFingerprint != "" {
+		// the signer is private to the sdk client, but it can sign any request.
+		// The version is irrelevant for that, so don't request it.
+		client, err := l.newClient(gitea.SetGiteaVersion(""))
+		if err != nil {
+			return nil, err
+		}
+		if err = client.SignRequest(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+func (l *Login) apiRequest(method, path, contentType string, body io.Reader, result interface{}) (*gitea.Response, error) {
+	req, err := l.newRequest(method, strings.TrimSuffix(l.URL, "/")+"/api/v1"+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if len(contentType) != 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	httpResp, err := l.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	resp := newResponse(httpResp)
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		// mimic the error messages of the sdk
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && len(apiErr.Message) != 0 {
+			return resp, utils.ErrorFromStatus(resp.StatusCode, fmt.Errorf("%s", apiErr.Message))
+		}
+		return resp, utils.ErrorFromStatus(resp.StatusCode, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(data))))
+	}
+
+	switch r := result.(type) {
+	case nil:
+		return resp, nil
+	case *[]byte:
+		*r = data
+		return resp, nil
+	default:
+		if len(data) == 0 || resp.StatusCode == http.StatusNoContent {
+			return resp, nil
+		}
+		return resp, json.Unmarshal(data, result)
+	}
+}
+
+// newResponse wraps resp like the sdk does, parsing the page numbers of the
+// pagination links in its Link header.
+func newResponse(resp *http.Response) *gitea.Response {
+	r := &gitea.Response{Response: resp}
+	for _, link := range strings.Split(resp.Header.Get("Link"), ",") {
+		target, param, ok := strings.Cut(link, ";")
+		if !ok {
+			continue
+		}
+		rel, ok := strings.CutPrefix(strings.TrimSpace(param), "rel=")
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(strings.Trim(target, " <>"))
+		if err != nil {
+			continue
+		}
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+		switch strings.Trim(rel, `"`) {
+		case "first":
+			r.FirstPage = page
+		case "prev":
+			r.PrevPage = page
+		case "next":
+			r.NextPage = page
+		case "last":
+			r.LastPage = page
+		}
+	}
+	return r
+}
+
+// ClassifyAPIError classifies an error returned by the sdk by the status code
+// of the response of the failed request, so it maps to the right exit code.
+// Errors without a response, or classified already, are returned unchanged.
+func ClassifyAPIError(resp *gitea.Response, err error) error {
+	if err == nil || resp == nil || resp.Response == nil || utils.ExitCode(err) != utils.ExitError {
+		return err
+	}
+	return utils.ErrorFromStatus(resp.StatusCode, err)
+}
@@ -5,7 +5,6 @@ package config
 
 import (
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"sync"
@@ -34,18 +33,20 @@ type Preferences struct {
 
 // LocalConfig represents local configurations
 type LocalConfig struct {
-	Logins []Login     `yaml:"logins"`
-	Prefs  Preferences `yaml:"preferences"`
+	Logins   []Login       `yaml:"logins"`
+	Prefs    Preferences   `yaml:"preferences"`
+	Searches []SavedSearch `yaml:"searches,omitempty"`
 }
 
 var (
 	// config contain if loaded local tea config
 	config         LocalConfig
 	loadConfigOnce sync.Once
+	loadConfigErr  error
 )
 
 // GetConfigPath return path to tea config file
-func GetConfigPath() string {
+func GetConfigPath() (string, error) {
 	configFilePath, err := xdg.ConfigFile("tea/config.yml")
 
 	var exists bool
@@ -60,15 +61,15 @@ func GetConfigPath() string {
 		file := filepath.Join(xdg.Home, ".tea", "tea.yml")
 		exists, _ = utils.PathExists(file)
 		if exists {
-			return file
+			return file, nil
 		}
 	}
 
 	if err != nil {
-		log.Fatal("unable to get or create config file")
+		return "", fmt.Errorf("unable to get or create config file: %w", err)
 	}
 
-	return configFilePath
+	return configFilePath, nil
 }
 
 // GetPreferences returns preferences based on the config file
@@ -78,28 +79,38 @@ func GetPreferences() Preferences {
 }
 
 // loadConfig load config from file
-func loadConfig() (err error) {
+func loadConfig() error {
 	loadConfigOnce.Do(func() {
-		ymlPath := GetConfigPath()
+		ymlPath, err := GetConfigPath()
+		if err != nil {
+			loadConfigErr = err
+			return
+		}
 		exist, _ := utils.FileExist(ymlPath)
 		if exist {
 			bs, err := os.ReadFile(ymlPath)
 			if err != nil {
-				err = fmt.Errorf("Failed to read config file: %s", ymlPath)
+				loadConfigErr = fmt.Errorf("Failed to read config file: %s", ymlPath)
+				return
 			}
 
-			err = yaml.Unmarshal(bs, &config)
-			if err != nil {
-				err = fmt.Errorf("Failed to parse contents of config file: %s", ymlPath)
+			if err = yaml.Unmarshal(bs, &config); err != nil {
+				loadConfigErr = fmt.Errorf("Failed to parse contents of config file: %s", ymlPath)
 			}
 		}
 	})
-	return
+	return loadConfigErr
 }
 
 // saveConfig save config to file
 func saveConfig() error {
-	ymlPath := GetConfigPath()
+	ymlPath, err := GetConfigPath()
+	if err != nil {
+		return err
+	}
+	if utils.SkipDryRun("write config file %s", ymlPath) {
+		return nil
+	}
 	bs, err := yaml.Marshal(config)
 	if err != nil {
 		return err
@@ -7,7 +7,6 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -40,6 +39,9 @@ type Login struct {
 	Created int64 `yaml:"created"`
 }
 
+// ErrNoLogin is returned when no login is configured
+var ErrNoLogin = errors.New("No available login")
+
 // GetLogins return all login available by config
 func GetLogins() ([]Login, error) {
 	if err := loadConfig(); err != nil {
@@ -55,7 +57,7 @@ func GetDefaultLogin() (*Login, error) {
 	}
 
 	if len(config.Logins) == 0 {
-		return nil, errors.New("No available login")
+		return nil, ErrNoLogin
 	}
 	for _, l := range config.Logins {
 		if l.Default {
@@ -89,52 +91,49 @@ func SetDefaultLogin(name string) error {
 }
 
 // GetLoginByName get login by name (case insensitive)
-func GetLoginByName(name string) *Login {
-	err := loadConfig()
-	if err != nil {
-		log.Fatal(err)
+func GetLoginByName(name string) (*Login, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
 	}
 
 	for _, l := range config.Logins {
 		if strings.ToLower(l.Name) == strings.ToLower(name) {
-			return &l
+			return &l, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 // GetLoginByToken get login by token
-func GetLoginByToken(token string) *Login {
-	err := loadConfig()
-	if err != nil {
-		log.Fatal(err)
+func GetLoginByToken(token string) (*Login, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
 	}
 
 	for _, l := range config.Logins {
 		if l.Token == token {
-			return &l
+			return &l, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 // GetLoginByHost finds a login by it's server URL
-func GetLoginByHost(host string) *Login {
-	err := loadConfig()
-	if err != nil {
-		log.Fatal(err)
+func GetLoginByHost(host string) (*Login, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
 	}
 
 	for _, l := range config.Logins {
 		loginURL, err := url.Parse(l.URL)
 		if err != nil {
-			log.Fatal(err)
+			return nil, fmt.Errorf("invalid URL of login '%s': %w", l.Name, err)
 		}
 		if loginURL.Host == host {
-			return &l
+			return &l, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 // DeleteLogin delete a login by name from config
@@ -169,19 +168,38 @@ func AddLogin(login *Login) error {
 }
 
 // Client returns a client to operate Gitea API. You may provide additional modifiers
-// for the client like gitea.SetBasicAuth() for customization
+// for the client like gitea.SetBasicAuth() for customization.
+// Errors while creating the client are returned by the first request made with it.
 func (l *Login) Client(options ...gitea.ClientOption) *gitea.Client {
+	client, err := l.newClient(options...)
+	if err != nil {
+		client, _ = gitea.NewClient(l.URL,
+			gitea.SetGiteaVersion(""),
+			gitea.SetHTTPClient(&http.Client{Transport: errorTransport{err}}))
+	}
+	return client
+}
+
+// httpClient returns the http client used for requests to the API of l.
+func (l *Login) httpClient() *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
 	httpClient := &http.Client{}
 	if l.Insecure {
 		cookieJar, _ := cookiejar.New(nil)
-
-		httpClient = &http.Client{
-			Jar: cookieJar,
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			},
+		httpClient.Jar = cookieJar
+		transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 		}
 	}
+	if utils.DryRun() {
+		transport = &dryRunTransport{base: transport}
+	}
+	httpClient.Transport = transport
+	return httpClient
+}
+
+func (l *Login) newClient(options ...gitea.ClientOption) (*gitea.Client, error) {
+	httpClient := l.httpClient()
 
 	// versioncheck must be prepended in options to make sure we don't hit any version checks in the sdk
 	if !l.VersionCheck {
@@ -191,9 +209,12 @@ func (l *Login) Client(options ...gitea.ClientOption) *gitea.Client {
 	options = append(options, gitea.SetToken(l.Token), gitea.SetHTTPClient(httpClient))
 
 	if ok, err := utils.IsKeyEncrypted(l.SSHKey); ok && err == nil && l.SSHPassphrase == "" {
+		if utils.NoInput() {
+			return nil, utils.NewInvalidArgumentErrorf("ssh-key '%s' is encrypted, but prompting for its passphrase is disabled by --no-input. Load the key into an ssh-agent instead", l.SSHKey)
+		}
 		promptPW := &survey.Password{Message: "ssh-key is encrypted please enter the passphrase: "}
 		if err = survey.AskOne(promptPW, &l.SSHPassphrase, survey.WithValidator(survey.Required)); err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 	}
 
@@ -209,11 +230,11 @@ func (l *Login) Client(options ...gitea.ClientOption) *gitea.Client {
 	if err != nil {
 		var versionError *gitea.ErrUnknownVersion
 		if !errors.As(err, &versionError) {
-			log.Fatal(err)
+			return nil, err
 		}
 		fmt.Fprintf(os.Stderr, "WARNING: could not detect gitea version: %s\nINFO: set gitea version: to last supported one\n", versionError)
 	}
-	return client
+	return client, nil
 }
 
 // GetSSHHost returns SSH host name
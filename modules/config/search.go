@@ -0,0 +1,175 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/tea/modules/git"
+	"code.gitea.io/tea/modules/utils"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RepoConfigFile is the name of the config file in the root of a repository,
+// which may hold searches shared by all contributors.
+const RepoConfigFile = ".tea.yml"
+
+// SavedSearch is a named set of flags for listing issues or pull requests
+type SavedSearch struct {
+	Name string `yaml:"name"`
+	// Command is the command which lists the results, issues or pulls
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args"`
+	// Shared is set for searches read from the repo config file
+	Shared bool `yaml:"-"`
+}
+
+// repoConfig represents the config file in the root of a repository
+type repoConfig struct {
+	Searches []SavedSearch `yaml:"searches"`
+}
+
+// GetRepoConfigPath returns the path of the repo config file of the
+// repository in the working directory.
+func GetRepoConfigPath() (string, error) {
+	repo, err := git.RepoForWorkdir()
+	if err != nil {
+		return "", fmt.Errorf("no local repository to store shared searches: %w", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(worktree.Filesystem.Root(), RepoConfigFile), nil
+}
+
+func loadRepoConfig(path string) (*repoConfig, error) {
+	c := &repoConfig{}
+	bs, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(bs, c); err != nil {
+		return nil, fmt.Errorf("Failed to parse contents of repo config file: %s", path)
+	}
+	for i := range c.Searches {
+		c.Searches[i].Shared = true
+	}
+	return c, nil
+}
+
+func saveRepoConfig(path string, c *repoConfig) error {
+	if utils.SkipDryRun("write repo config file %s", path) {
+		return nil
+	}
+	bs, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bs, 0o644)
+}
+
+// GetSavedSearches returns the searches of the config file, followed by the
+// searches shared in the repo config file, if the working directory is a repository.
+func GetSavedSearches() ([]SavedSearch, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
+	}
+	searches := append([]SavedSearch{}, config.Searches...)
+	if path, err := GetRepoConfigPath(); err == nil {
+		repoConf, err := loadRepoConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, repoConf.Searches...)
+	}
+	return searches, nil
+}
+
+// GetSavedSearch returns the search with the given name (case insensitive).
+// Searches of the config file take precedence over shared ones.
+func GetSavedSearch(name string) (*SavedSearch, error) {
+	searches, err := GetSavedSearches()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range searches {
+		if strings.EqualFold(s.Name, name) {
+			return &s, nil
+		}
+	}
+	return nil, utils.NewNotExistErrorf("saved search '%s' does not exist", name)
+}
+
+// SaveSearch stores search in the repo config file if it is shared, or in
+// the config file otherwise. A search with the same name is replaced.
+func SaveSearch(search SavedSearch) error {
+	if search.Shared {
+		path, err := GetRepoConfigPath()
+		if err != nil {
+			return err
+		}
+		repoConf, err := loadRepoConfig(path)
+		if err != nil {
+			return err
+		}
+		repoConf.Searches = replaceSearch(repoConf.Searches, search)
+		return saveRepoConfig(path, repoConf)
+	}
+
+	if err := loadConfig(); err != nil {
+		return err
+	}
+	config.Searches = replaceSearch(config.Searches, search)
+	return saveConfig()
+}
+
+// DeleteSearch deletes a saved search by name, from the repo config file if
+// shared is set, or from the config file otherwise.
+func DeleteSearch(name string, shared bool) error {
+	if shared {
+		path, err := GetRepoConfigPath()
+		if err != nil {
+			return err
+		}
+		repoConf, err := loadRepoConfig(path)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		if repoConf.Searches, ok = removeSearch(repoConf.Searches, name); !ok {
+			return utils.NewNotExistErrorf("can not delete shared search '%s', does not exist", name)
+		}
+		return saveRepoConfig(path, repoConf)
+	}
+
+	if err := loadConfig(); err != nil {
+		return err
+	}
+	var ok bool
+	if config.Searches, ok = removeSearch(config.Searches, name); !ok {
+		return utils.NewNotExistErrorf("can not delete search '%s', does not exist", name)
+	}
+	return saveConfig()
+}
+
+func replaceSearch(searches []SavedSearch, search SavedSearch) []SavedSearch {
+	searches, _ = removeSearch(searches, search.Name)
+	return append(searches, search)
+}
+
+func removeSearch(searches []SavedSearch, name string) ([]SavedSearch, bool) {
+	for i, s := range searches {
+		if strings.EqualFold(s.Name, name) {
+			return append(searches[:i], searches[i+1:]...), true
+		}
+	}
+	return searches, false
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"code.gitea.io/tea/modules/utils"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchNames(searches []SavedSearch) []string {
+	names := make([]string, len(searches))
+	for i, s := range searches {
+		names[i] = s.Name
+	}
+	return names
+}
+
+func TestReplaceSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		searches []string
+		search   string
+		want     []string
+	}{
+		{name: "empty", searches: nil, search: "bugs", want: []string{"bugs"}},
+		{name: "new", searches: []string{"bugs", "mine"}, search: "ci", want: []string{"bugs", "mine", "ci"}},
+		{name: "existing", searches: []string{"bugs", "mine"}, search: "bugs", want: []string{"mine", "bugs"}},
+		{name: "case insensitive", searches: []string{"Bugs", "mine"}, search: "bugs", want: []string{"mine", "bugs"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var searches []SavedSearch
+			for _, name := range tt.searches {
+				searches = append(searches, SavedSearch{Name: name, Command: "issues"})
+			}
+			got := replaceSearch(searches, SavedSearch{Name: tt.search, Command: "pulls"})
+			assert.Equal(t, tt.want, searchNames(got))
+			assert.Equal(t, "pulls", got[len(got)-1].Command)
+		})
+	}
+}
+
+func TestRemoveSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		searches []string
+		remove   string
+		want     []string
+		wantOk   bool
+	}{
+		{name: "empty", searches: nil, remove: "bugs", want: []string{}, wantOk: false},
+		{name: "missing", searches: []string{"bugs"}, remove: "ci", want: []string{"bugs"}, wantOk: false},
+		{name: "first", searches: []string{"bugs", "mine", "ci"}, remove: "bugs", want: []string{"mine", "ci"}, wantOk: true},
+		{name: "last", searches: []string{"bugs", "mine", "ci"}, remove: "ci", want: []string{"bugs", "mine"}, wantOk: true},
+		{name: "case insensitive", searches: []string{"bugs", "Mine"}, remove: "MINE", want: []string{"bugs"}, wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var searches []SavedSearch
+			for _, name := range tt.searches {
+				searches = append(searches, SavedSearch{Name: name})
+			}
+			got, ok := removeSearch(searches, tt.remove)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, searchNames(got))
+		})
+	}
+}
+
+func TestGetSavedSearch(t *testing.T) {
+	// searches of the config file
+	loadConfigOnce.Do(func() {})
+	config.Searches = []SavedSearch{
+		{Name: "Bugs", Command: "issues", Args: []string{"--labels", "bug"}},
+	}
+	t.Cleanup(func() { config.Searches = nil })
+
+	// searches shared in the repo of the working directory
+	dir := t.TempDir()
+	_, err := gogit.PlainInit(dir, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, RepoConfigFile), []byte(`searches:
+- name: bugs
+  command: pulls
+- name: ci
+  command: pulls
+  args: [--ci, failure]
+`), 0o644))
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	tests := []struct {
+		name    string
+		want    *SavedSearch
+		wantErr error
+	}{
+		{name: "bugs", want: &SavedSearch{Name: "Bugs", Command: "issues", Args: []string{"--labels", "bug"}}},
+		{name: "CI", want: &SavedSearch{Name: "ci", Command: "pulls", Args: []string{"--ci", "failure"}, Shared: true}},
+		{name: "missing", wantErr: utils.ErrNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetSavedSearch(tt.name)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
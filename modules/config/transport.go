@@ -0,0 +1,58 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.gitea.io/tea/modules/utils"
+)
+
+// dryRunTransport prints mutating requests instead of sending them, and fails
+// them with utils.ErrDryRun. Other requests are passed to base.
+type dryRunTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+	default:
+		return t.base.RoundTrip(req)
+	}
+
+	var body string
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		var compact bytes.Buffer
+		if json.Compact(&compact, data) == nil {
+			body = "\n  " + compact.String()
+		} else if len(data) != 0 {
+			body = fmt.Sprintf("\n  (%d bytes of %s)", len(data), req.Header.Get("Content-Type"))
+		}
+	}
+	utils.SkipDryRun("%s %s%s", req.Method, req.URL.Redacted(), body)
+	return nil, utils.ErrDryRun
+}
+
+// errorTransport fails all requests with err. It is used to defer errors
+// while creating a client to the first request, so they are returned like
+// any other API error.
+type errorTransport struct {
+	err error
+}
+
+// RoundTrip implements http.RoundTripper
+func (t errorTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
@@ -6,14 +6,16 @@ package context
 import (
 	"errors"
 	"fmt"
-	"log"
+	"net/url"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/git"
+	"code.gitea.io/tea/modules/print"
 	"code.gitea.io/tea/modules/utils"
 
 	gogit "github.com/go-git/go-git/v5"
@@ -22,6 +24,9 @@ import (
 
 var (
 	errNotAGiteaRepo = errors.New("No Gitea login found. You might want to specify --repo (and --login) to work outside of a repository")
+	errNoLogin       = utils.NewInvalidArgumentErrorf(`No gitea login configured. To start using tea, first run
+  tea login add
+and then run your command again.`)
 )
 
 // TeaContext contains all context derived during command initialization and wraps cli.Context
@@ -33,6 +38,80 @@ type TeaContext struct {
 	Repo      string        // repo name as derived from context or provided in flag, optional
 	Output    string        // value of output flag
 	LocalRepo *git.TeaRepo  // is set if flags specified a local repo via --repo, or if $PWD is a git repo
+
+	localRepoSlug string         // repo slug derived from the remotes of LocalRepo
+	indexRef      utils.IndexRef // first index argument referencing a specific repo
+}
+
+// RemoteIsLocalRepo reports whether LocalRepo is a checkout of the remote repo
+func (ctx *TeaContext) RemoteIsLocalRepo() bool {
+	if ctx.LocalRepo == nil || len(ctx.localRepoSlug) == 0 {
+		return false
+	}
+	owner, repo := utils.GetOwnerAndRepo(ctx.localRepoSlug, ctx.Login.User)
+	return strings.EqualFold(owner, ctx.Owner) && strings.EqualFold(repo, ctx.Repo)
+}
+
+// ArgToIndex parses an issue or pull request index in one of the formats
+// accepted by utils.ParseIndexRef. An index referencing a specific repo switches
+// the context to that repo, and to the login matching the host of a web URL.
+// It has to be called before the context is used to access the repo.
+func (ctx *TeaContext) ArgToIndex(arg string) (int64, error) {
+	ref, err := utils.ParseIndexRef(arg)
+	if err != nil {
+		return 0, err
+	}
+	if err = ctx.useIndexRef(ref); err != nil {
+		return 0, err
+	}
+	return ref.Index, nil
+}
+
+// ArgsToIndices parses multiple indices like ArgToIndex, which must not reference different repos.
+func (ctx *TeaContext) ArgsToIndices(args []string) ([]int64, error) {
+	indices := make([]int64, len(args))
+	for i, arg := range args {
+		var err error
+		if indices[i], err = ctx.ArgToIndex(arg); err != nil {
+			return nil, err
+		}
+	}
+	return indices, nil
+}
+
+// useIndexRef switches repo & login to the ones referenced by ref
+func (ctx *TeaContext) useIndexRef(ref utils.IndexRef) error {
+	if len(ref.Owner) == 0 {
+		return nil
+	}
+	if found := ctx.indexRef; len(found.Owner) != 0 {
+		if !strings.EqualFold(ref.RepoSlug(), found.RepoSlug()) ||
+			(len(ref.Host) != 0 && len(found.Host) != 0 && ref.Host != found.Host) {
+			return utils.NewInvalidArgumentErrorf("arguments refer to different repositories: '%s' and '%s'", found.RepoSlug(), ref.RepoSlug())
+		}
+	} else if ctx.IsSet("repo") && (!strings.EqualFold(ref.Owner, ctx.Owner) || !strings.EqualFold(ref.Repo, ctx.Repo)) {
+		return utils.NewInvalidArgumentErrorf("index refers to repository '%s', but --repo is '%s/%s'", ref.RepoSlug(), ctx.Owner, ctx.Repo)
+	}
+
+	if len(ref.Host) != 0 && len(ctx.indexRef.Host) == 0 && !ctx.IsSet("login") {
+		loginURL, err := url.Parse(ctx.Login.URL)
+		if err != nil || loginURL.Host != ref.Host {
+			login, err := config.GetLoginByHost(ref.Host)
+			if err != nil {
+				return err
+			}
+			if login == nil {
+				return utils.NewNotExistErrorf("No login configured matching host '%s', run `tea login add` first", ref.Host)
+			}
+			ctx.Login = login
+		}
+	}
+
+	if len(ctx.indexRef.Owner) == 0 || len(ctx.indexRef.Host) == 0 {
+		ctx.indexRef = ref
+	}
+	ctx.RepoSlug, ctx.Owner, ctx.Repo = ref.RepoSlug(), ref.Owner, ref.Repo
+	return nil
 }
 
 // GetListOptions return ListOptions based on PaginationFlags
@@ -51,24 +130,22 @@ func (ctx *TeaContext) GetListOptions() gitea.ListOptions {
 	}
 }
 
-// GetRemoteRepoHTMLURL returns the web-ui url of the remote repo,
-// after ensuring a remote repo is present in the context.
+// GetRemoteRepoHTMLURL returns the web-ui url of the remote repo.
+// Requires a remote repo to be ensured in the context.
 func (ctx *TeaContext) GetRemoteRepoHTMLURL() string {
-	ctx.Ensure(CtxRequirement{RemoteRepo: true})
 	return path.Join(ctx.Login.URL, ctx.Owner, ctx.Repo)
 }
 
-// Ensure checks if requirements on the context are set, and terminates otherwise.
-func (ctx *TeaContext) Ensure(req CtxRequirement) {
+// Ensure checks if requirements on the context are set, and returns an error otherwise.
+func (ctx *TeaContext) Ensure(req CtxRequirement) error {
 	if req.LocalRepo && ctx.LocalRepo == nil {
-		fmt.Println("Local repository required: Execute from a repo dir, or specify a path with --repo.")
-		os.Exit(1)
+		return utils.NewInvalidArgumentErrorf("Local repository required: Execute from a repo dir, or specify a path with --repo.")
 	}
 
 	if req.RemoteRepo && len(ctx.RepoSlug) == 0 {
-		fmt.Println("Remote repository required: Specify ID via --repo or execute from a local git repo.")
-		os.Exit(1)
+		return utils.NewInvalidArgumentErrorf("Remote repository required: Specify ID via --repo or execute from a local git repo.")
 	}
+	return nil
 }
 
 // CtxRequirement specifies context needed for operation
@@ -79,11 +156,33 @@ type CtxRequirement struct {
 	RemoteRepo bool
 }
 
+// checkLoginsSupported fails if the global --all-logins or --logins flags are
+// given to a command which doesn't run against several logins, and so does
+// not define them itself.
+func checkLoginsSupported(ctx *cli.Context) error {
+	if all, names := utils.GlobalLogins(); !all && names == "" {
+		return nil
+	}
+	if ctx.Command == nil {
+		return utils.NewInvalidArgumentErrorf("--all-logins and --logins are not supported by this command")
+	}
+	for _, f := range ctx.Command.Flags {
+		if slices.Contains(f.Names(), "all-logins") {
+			return nil
+		}
+	}
+	return utils.NewInvalidArgumentErrorf("--all-logins and --logins are not supported by '%s'", ctx.Command.HelpName)
+}
+
 // InitCommand resolves the application context, and returns the active login, and if
 // available the repo slug. It does this by reading the config file for logins, parsing
 // the remotes of the .git repo specified in repoFlag or $PWD, and using overrides from
 // command flags. If a local git repo can't be found, repo slug values are unset.
-func InitCommand(ctx *cli.Context) *TeaContext {
+func InitCommand(ctx *cli.Context) (*TeaContext, error) {
+	if err := checkLoginsSupported(ctx); err != nil {
+		return nil, err
+	}
+
 	// these flags are used as overrides to the context detection via local git repo
 	repoFlag := ctx.String("repo")
 	loginFlag := ctx.String("login")
@@ -99,7 +198,7 @@ func InitCommand(ctx *cli.Context) *TeaContext {
 	// check if repoFlag can be interpreted as path to local repo.
 	if len(repoFlag) != 0 {
 		if repoFlagPathExists, err = utils.DirExists(repoFlag); err != nil {
-			log.Fatal(err.Error())
+			return nil, err
 		}
 		if repoFlagPathExists {
 			repoPath = repoFlag
@@ -116,9 +215,10 @@ func InitCommand(ctx *cli.Context) *TeaContext {
 		if err == errNotAGiteaRepo || err == gogit.ErrRepositoryNotExists {
 			// we can deal with that, commands needing the optional values use ctx.Ensure()
 		} else {
-			log.Fatal(err.Error())
+			return nil, err
 		}
 	}
+	c.localRepoSlug = c.RepoSlug
 
 	if len(repoFlag) != 0 && !repoFlagPathExists {
 		// if repoFlag is not a valid path, use it to override repoSlug
@@ -127,20 +227,20 @@ func InitCommand(ctx *cli.Context) *TeaContext {
 
 	// override login from flag, or use default login if repo based detection failed
 	if len(loginFlag) != 0 {
-		c.Login = config.GetLoginByName(loginFlag)
+		if c.Login, err = config.GetLoginByName(loginFlag); err != nil {
+			return nil, err
+		}
 		if c.Login == nil {
-			log.Fatalf("Login name '%s' does not exist", loginFlag)
+			return nil, utils.NewInvalidArgumentErrorf("Login name '%s' does not exist", loginFlag)
 		}
 	} else if c.Login == nil {
 		if c.Login, err = config.GetDefaultLogin(); err != nil {
-			if err.Error() == "No available login" {
+			if errors.Is(err, config.ErrNoLogin) {
 				// TODO: maybe we can directly start interact.CreateLogin() (only if
 				// we're sure we can interactively!), as gh cli does.
-				fmt.Println(`No gitea login configured. To start using tea, first run
-  tea login add
-and then run your command again.`)
+				return nil, errNoLogin
 			}
-			os.Exit(1)
+			return nil, err
 		}
 		fmt.Fprintf(os.Stderr, "NOTE: no gitea login detected, falling back to login '%s'\n", c.Login.Name)
 	}
@@ -150,7 +250,10 @@ and then run your command again.`)
 
 	c.Context = ctx
 	c.Output = ctx.String("output")
-	return &c
+	if err = print.ValidateOutput(c.Output); err != nil {
+		return nil, err
+	}
+	return &c, nil
 }
 
 // contextFromLocalRepo discovers login & repo slug from the default branch remote of the given local repo
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 
+	"code.gitea.io/tea/modules/utils"
+
 	"github.com/go-git/go-git/v5"
 	git_config "github.com/go-git/go-git/v5/config"
 	git_plumbing "github.com/go-git/go-git/v5/plumbing"
@@ -15,6 +17,9 @@ import (
 
 // TeaCreateBranch creates a new branch in the repo, tracking from another branch.
 func (r TeaRepo) TeaCreateBranch(localBranchName, remoteBranchName, remoteName string) error {
+	if utils.SkipDryRun("git: create branch '%s' tracking '%s/%s'", localBranchName, remoteName, remoteBranchName) {
+		return nil
+	}
 	// save in .git/config to assign remote for future pulls
 	localBranchRefName := git_plumbing.NewBranchReferenceName(localBranchName)
 	err := r.CreateBranch(&git_config.Branch{
@@ -38,6 +43,9 @@ func (r TeaRepo) TeaCreateBranch(localBranchName, remoteBranchName, remoteName s
 
 // TeaCheckout checks out the given branch in the worktree.
 func (r TeaRepo) TeaCheckout(ref git_plumbing.ReferenceName) error {
+	if utils.SkipDryRun("git: checkout %s", ref) {
+		return nil
+	}
 	tree, err := r.Worktree()
 	if err != nil {
 		return err
@@ -47,6 +55,9 @@ func (r TeaRepo) TeaCheckout(ref git_plumbing.ReferenceName) error {
 
 // TeaDeleteLocalBranch removes the given branch locally
 func (r TeaRepo) TeaDeleteLocalBranch(branch *git_config.Branch) error {
+	if utils.SkipDryRun("git: delete local branch '%s'", branch.Name) {
+		return nil
+	}
 	err := r.DeleteBranch(branch.Name)
 	// if the branch is not found that's ok, as .git/config may have no entry if
 	// no remote tracking branch is configured for it (eg push without -u flag)
@@ -58,6 +69,9 @@ func (r TeaRepo) TeaDeleteLocalBranch(branch *git_config.Branch) error {
 
 // TeaDeleteRemoteBranch removes the given branch on the given remote via git protocol
 func (r TeaRepo) TeaDeleteRemoteBranch(remoteName, remoteBranch string, auth git_transport.AuthMethod) error {
+	if utils.SkipDryRun("git: delete branch '%s' on remote '%s'", remoteBranch, remoteName) {
+		return nil
+	}
 	// delete remote branch via git protocol:
 	// an empty source in the refspec means remote deletion to git 🙃
 	refspec := fmt.Sprintf(":%s", git_plumbing.NewBranchReferenceName(remoteBranch))
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/url"
 
+	"code.gitea.io/tea/modules/utils"
+
 	"github.com/go-git/go-git/v5"
 	git_config "github.com/go-git/go-git/v5/config"
 )
@@ -49,6 +51,9 @@ func (r TeaRepo) GetOrCreateRemote(remoteURL, newRemoteName string) (*git.Remote
 
 	// if no match found, create a new remote
 	if localRemote == nil {
+		if utils.SkipDryRun("git: add remote '%s' with url %s", newRemoteName, remoteURL) {
+			return git.NewRemote(r.Storer, &git_config.RemoteConfig{Name: newRemoteName, URLs: []string{remoteURL}}), nil
+		}
 		localRemote, err = r.CreateRemote(&git_config.RemoteConfig{
 			Name: newRemoteName,
 			URLs: []string{remoteURL},
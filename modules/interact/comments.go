@@ -8,8 +8,10 @@ import (
 	"os"
 
 	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/AlecAivazis/survey/v2"
 	"golang.org/x/term"
@@ -21,12 +23,12 @@ func ShowCommentsMaybeInteractive(ctx *context.TeaContext, idx int64, totalComme
 	if ctx.Bool("comments") {
 		opts := gitea.ListIssueCommentOptions{ListOptions: ctx.GetListOptions()}
 		c := ctx.Login.Client()
-		comments, _, err := c.ListIssueComments(ctx.Owner, ctx.Repo, idx, opts)
+		comments, resp, err := c.ListIssueComments(ctx.Owner, ctx.Repo, idx, opts)
 		if err != nil {
-			return err
+			return config.ClassifyAPIError(resp, err)
 		}
-		print.Comments(comments)
-	} else if print.IsInteractive() && !ctx.IsSet("comments") {
+		print.Comments(comments, commentReactions(ctx, comments))
+	} else if print.IsInteractive() && !utils.NoInput() && !ctx.IsSet("comments") {
 		// if we're interactive, but --comments hasn't been explicitly set to false
 		if err := ShowCommentsPaginated(ctx, idx, totalComments); err != nil {
 			fmt.Printf("error while loading comments: %v\n", err)
@@ -48,15 +50,15 @@ func ShowCommentsPaginated(ctx *context.TeaContext, idx int64, totalComments int
 	for {
 		loadComments := false
 		confirm := survey.Confirm{Message: prompt, Default: true}
-		if err := survey.AskOne(&confirm, &loadComments); err != nil {
+		if err := AskOne(&confirm, &loadComments, "--comments"); err != nil {
 			return err
 		} else if !loadComments {
 			break
 		} else {
-			if comments, _, err := c.ListIssueComments(ctx.Owner, ctx.Repo, idx, opts); err != nil {
-				return err
+			if comments, resp, err := c.ListIssueComments(ctx.Owner, ctx.Repo, idx, opts); err != nil {
+				return config.ClassifyAPIError(resp, err)
 			} else if len(comments) != 0 {
-				print.Comments(comments)
+				print.Comments(comments, commentReactions(ctx, comments))
 				commentsLoaded += len(comments)
 			}
 			if commentsLoaded >= totalComments {
@@ -69,6 +71,23 @@ func ShowCommentsPaginated(ctx *context.TeaContext, idx int64, totalComments int
 	return nil
 }
 
+// commentReactions fetches the reactions on each of comments, which takes a
+// request per comment. As they are only decoration, they are fetched only for
+// output to a terminal, and comments whose reactions can't be fetched are skipped.
+func commentReactions(ctx *context.TeaContext, comments []*gitea.Comment) map[int64][]*gitea.Reaction {
+	if !print.IsInteractive() {
+		return nil
+	}
+	c := ctx.Login.Client()
+	reactions := make(map[int64][]*gitea.Reaction, len(comments))
+	for _, comment := range comments {
+		if r, _, err := c.GetIssueCommentReactions(ctx.Owner, ctx.Repo, comment.ID); err == nil {
+			reactions[comment.ID] = r
+		}
+	}
+	return reactions
+}
+
 // IsStdinPiped checks if stdin is piped
 func IsStdinPiped() bool {
 	return !term.IsTerminal(int(os.Stdin.Fd()))
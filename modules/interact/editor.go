@@ -0,0 +1,38 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package interact
+
+import (
+	"os"
+
+	"code.gitea.io/tea/modules/task"
+)
+
+// EditText opens text in $VISUAL or $EDITOR, and returns the edited text.
+// flag names the argument that has to be provided instead, if there is no
+// terminal to run the editor in.
+func EditText(text, flag string) (string, error) {
+	if err := checkSelectable(flag); err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp("", "tea-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.WriteString(text); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err = f.Close(); err != nil {
+		return "", err
+	}
+
+	if err = task.OpenFileInEditor(f.Name()); err != nil {
+		return "", err
+	}
+	edited, err := os.ReadFile(f.Name())
+	return string(edited), err
+}
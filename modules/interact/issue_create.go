@@ -6,50 +6,75 @@ package interact
 import (
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/task"
 
 	"github.com/AlecAivazis/survey/v2"
 )
 
 // CreateIssue interactively creates an issue
-func CreateIssue(login *config.Login, owner, repo string) error {
-	owner, repo, err := promptRepoSlug(owner, repo)
+func CreateIssue(ctx *context.TeaContext) (err error) {
+	if ctx.Owner, ctx.Repo, err = promptRepoSlug(ctx.Owner, ctx.Repo); err != nil {
+		return err
+	}
+
+	templates, err := findTemplates(ctx, task.FindIssueTemplates)
+	if err != nil {
+		return err
+	}
+	tmpl, err := promptTemplate(ctx, templates)
 	if err != nil {
 		return err
 	}
 
 	var opts gitea.CreateIssueOption
-	if err := promptIssueProperties(login, owner, repo, &opts); err != nil {
+	if err := promptIssueProperties(ctx.Login, ctx.Owner, ctx.Repo, &opts, tmpl); err != nil {
 		return err
 	}
 
-	return task.CreateIssue(login, owner, repo, opts)
+	return task.CreateIssue(ctx.Login, ctx.Owner, ctx.Repo, opts)
 }
 
-func promptIssueProperties(login *config.Login, owner, repo string, o *gitea.CreateIssueOption) error {
+// promptIssueProperties prompts for the properties of an issue or PR.
+// If tmpl is set, its values are used as defaults.
+func promptIssueProperties(login *config.Login, owner, repo string, o *gitea.CreateIssueOption, tmpl *task.IssueTemplate) error {
 	var milestoneName string
-	var labels []string
+	var labels, defaultLabels, defaultAssignees []string
 	var err error
 
 	selectableChan := make(chan (issueSelectables), 1)
 	go fetchIssueSelectables(login, owner, repo, selectableChan)
 
+	if tmpl != nil {
+		if len(o.Title) == 0 {
+			o.Title = tmpl.Title
+		}
+		o.Body = tmpl.Content
+		defaultLabels, defaultAssignees = tmpl.Labels, tmpl.Assignees
+	}
+
 	// title
 	promptOpts := survey.WithValidator(survey.Required)
 	promptI := &survey.Input{Message: "Issue title:", Default: o.Title}
-	if err = survey.AskOne(promptI, &o.Title, promptOpts); err != nil {
+	if err = AskOne(promptI, &o.Title, "--title", promptOpts); err != nil {
 		return err
 	}
 
 	// description
-	promptD := NewMultiline(Multiline{
-		Message:   "Issue description:",
-		Default:   o.Body,
-		Syntax:    "md",
-		UseEditor: config.GetPreferences().Editor,
-	})
-	if err = survey.AskOne(promptD, &o.Body); err != nil {
-		return err
+	if tmpl != nil && tmpl.IsForm() {
+		if o.Body, err = promptIssueForm(tmpl); err != nil {
+			return err
+		}
+	} else {
+		promptD := NewMultiline(Multiline{
+			Message:   "Issue description:",
+			Default:   o.Body,
+			Syntax:    "md",
+			UseEditor: config.GetPreferences().Editor,
+		})
+		if err = AskOne(promptD, &o.Body, "--description"); err != nil {
+			return err
+		}
 	}
 
 	// wait until selectables are fetched
@@ -64,13 +89,13 @@ func promptIssueProperties(login *config.Login, owner, repo string, o *gitea.Cre
 	}
 
 	// assignees
-	if o.Assignees, err = promptMultiSelect("Assignees:", selectables.Assignees, "[other]"); err != nil {
+	if o.Assignees, err = promptMultiSelect("Assignees:", "--assignees", selectables.Assignees, defaultAssignees, "[other]"); err != nil {
 		return err
 	}
 
 	// milestone
 	if len(selectables.MilestoneList) != 0 {
-		if milestoneName, err = promptSelect("Milestone:", selectables.MilestoneList, "", "[none]"); err != nil {
+		if milestoneName, err = promptSelect("Milestone:", "--milestone", selectables.MilestoneList, "", "[none]"); err != nil {
 			return err
 		}
 		o.Milestone = selectables.MilestoneMap[milestoneName]
@@ -78,8 +103,13 @@ func promptIssueProperties(login *config.Login, owner, repo string, o *gitea.Cre
 
 	// labels
 	if len(selectables.LabelList) != 0 {
-		promptL := &survey.MultiSelect{Message: "Labels:", Options: selectables.LabelList, VimMode: true, Default: o.Labels}
-		if err := survey.AskOne(promptL, &labels); err != nil {
+		promptL := &survey.MultiSelect{
+			Message: "Labels:",
+			Options: selectables.LabelList,
+			VimMode: true,
+			Default: filterSelectOpts(defaultLabels, selectables.LabelList),
+		}
+		if err := AskOne(promptL, &labels, "--labels"); err != nil {
 			return err
 		}
 		o.Labels = make([]int64, len(labels))
@@ -89,7 +119,7 @@ func promptIssueProperties(login *config.Login, owner, repo string, o *gitea.Cre
 	}
 
 	// deadline
-	if o.Deadline, err = promptDatetime("Due date:"); err != nil {
+	if o.Deadline, err = promptDatetime("Due date:", "--deadline"); err != nil {
 		return err
 	}
 
@@ -0,0 +1,141 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package interact
+
+import (
+	"fmt"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// findTemplates returns the templates found by find. Templates are optional,
+// so lookup errors count as no templates, unless one was given via --template.
+func findTemplates(ctx *context.TeaContext, find func(*context.TeaContext) ([]*task.IssueTemplate, error)) ([]*task.IssueTemplate, error) {
+	templates, err := find(ctx)
+	if err != nil && !ctx.IsSet("template") {
+		return nil, nil
+	}
+	return templates, err
+}
+
+// promptTemplate lets the user choose one of templates, unless one was given
+// via --template. A nil template means no template should be used.
+func promptTemplate(ctx *context.TeaContext, templates []*task.IssueTemplate) (*task.IssueTemplate, error) {
+	if name := ctx.String("template"); len(name) != 0 {
+		return task.GetTemplate(templates, name)
+	}
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	const blankOption = "[blank]"
+	options := make([]string, 0, len(templates)+1)
+	for _, t := range templates {
+		options = append(options, t.Name)
+	}
+	options = append(options, blankOption)
+
+	var selected int
+	promptT := &survey.Select{
+		Message: "Template:",
+		Options: options,
+		Description: func(_ string, i int) string {
+			if i < len(templates) {
+				return templates[i].About
+			}
+			return ""
+		},
+	}
+	if err := AskOne(promptT, &selected, "--template"); err != nil {
+		return nil, err
+	}
+	if selected == len(templates) {
+		return nil, nil
+	}
+	return templates[selected], nil
+}
+
+// promptIssueForm prompts for each field of an issue form, and returns the
+// rendered body.
+func promptIssueForm(t *task.IssueTemplate) (string, error) {
+	values := make([][]string, len(t.Fields))
+	for i, f := range t.Fields {
+		attrs := f.Attributes
+		switch f.Type {
+		case "markdown":
+			fmt.Println(attrs.Value)
+
+		case "input", "textarea":
+			var value string
+			var prompt survey.Prompt
+			if f.Type == "input" {
+				prompt = &survey.Input{Message: attrs.Label + ":", Default: attrs.Value, Help: attrs.Description}
+			} else {
+				prompt = NewMultiline(Multiline{
+					Message:   attrs.Label + ":",
+					Default:   attrs.Value,
+					Syntax:    "md",
+					UseEditor: config.GetPreferences().Editor,
+				})
+			}
+			validate := survey.WithValidator(func(ans interface{}) error {
+				return f.Validate(fmt.Sprint(ans))
+			})
+			if err := AskOne(prompt, &value, "--description", validate); err != nil {
+				return "", err
+			}
+			values[i] = []string{value}
+
+		case "dropdown", "checkboxes":
+			options := make([]string, len(attrs.Options))
+			var requiredOptions []string
+			for j, o := range attrs.Options {
+				options[j] = o.Label
+				if o.Required {
+					requiredOptions = append(requiredOptions, o.Label)
+				}
+			}
+			if f.Type == "dropdown" && !attrs.Multiple {
+				var value string
+				opts := []survey.AskOpt{}
+				if f.Validations.Required {
+					opts = append(opts, survey.WithValidator(survey.Required))
+				}
+				prompt := &survey.Select{Message: attrs.Label + ":", Options: options, Help: attrs.Description}
+				if err := AskOne(prompt, &value, "--description", opts...); err != nil {
+					return "", err
+				}
+				values[i] = []string{value}
+				continue
+			}
+
+			var selection []string
+			validate := survey.WithValidator(func(ans interface{}) error {
+				selected := map[string]bool{}
+				for _, o := range ans.([]survey.OptionAnswer) {
+					selected[o.Value] = true
+				}
+				if f.Validations.Required && len(selected) == 0 {
+					return fmt.Errorf("'%s' is required", attrs.Label)
+				}
+				for _, o := range requiredOptions {
+					if !selected[o] {
+						return fmt.Errorf("'%s' must be checked", o)
+					}
+				}
+				return nil
+			})
+			prompt := &survey.MultiSelect{Message: attrs.Label + ":", Options: options, Help: attrs.Description, VimMode: true}
+			if err := AskOne(prompt, &selection, "--description", validate); err != nil {
+				return "", err
+			}
+			values[i] = selection
+		}
+	}
+	return t.RenderForm(values), nil
+}
@@ -25,7 +25,7 @@ func CreateLogin() error {
 	helper = false
 
 	promptI := &survey.Input{Message: "URL of Gitea instance: "}
-	if err := survey.AskOne(promptI, &giteaURL, survey.WithValidator(survey.Required)); err != nil {
+	if err := AskOne(promptI, &giteaURL, "--url", survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 	giteaURL = strings.TrimSuffix(strings.TrimSpace(giteaURL), "/")
@@ -40,11 +40,11 @@ func CreateLogin() error {
 	}
 
 	promptI = &survey.Input{Message: "Name of new Login: ", Default: name}
-	if err := survey.AskOne(promptI, &name); err != nil {
+	if err := AskOne(promptI, &name, "--name"); err != nil {
 		return err
 	}
 
-	loginMethod, err := promptSelectV2("Login with: ", []string{"token", "ssh-key/certificate"})
+	loginMethod, err := promptSelectV2("Login with: ", "--token or --ssh-key", []string{"token", "ssh-key/certificate"})
 	if err != nil {
 		return err
 	}
@@ -56,47 +56,47 @@ func CreateLogin() error {
 			Message: "Do you have an access token?",
 			Default: false,
 		}
-		if err = survey.AskOne(promptYN, &hasToken); err != nil {
+		if err = AskOne(promptYN, &hasToken, "--token"); err != nil {
 			return err
 		}
 
 		if hasToken {
 			promptI = &survey.Input{Message: "Token: "}
-			if err := survey.AskOne(promptI, &token, survey.WithValidator(survey.Required)); err != nil {
+			if err := AskOne(promptI, &token, "--token", survey.WithValidator(survey.Required)); err != nil {
 				return err
 			}
 		} else {
 			promptI = &survey.Input{Message: "Username: "}
-			if err = survey.AskOne(promptI, &user, survey.WithValidator(survey.Required)); err != nil {
+			if err = AskOne(promptI, &user, "--user", survey.WithValidator(survey.Required)); err != nil {
 				return err
 			}
 
 			promptPW := &survey.Password{Message: "Password: "}
-			if err = survey.AskOne(promptPW, &passwd, survey.WithValidator(survey.Required)); err != nil {
+			if err = AskOne(promptPW, &passwd, "--password", survey.WithValidator(survey.Required)); err != nil {
 				return err
 			}
 
 			var tokenScopes []string
 			promptS := &survey.MultiSelect{Message: "Token Scopes:", Options: tokenScopeOpts}
-			if err := survey.AskOne(promptS, &tokenScopes, survey.WithValidator(survey.Required)); err != nil {
+			if err := AskOne(promptS, &tokenScopes, "--scopes", survey.WithValidator(survey.Required)); err != nil {
 				return err
 			}
 			scopes = strings.Join(tokenScopes, ",")
 
 			// Ask for OTP last so it's less likely to timeout
 			promptO := &survey.Input{Message: "OTP (if applicable)"}
-			if err := survey.AskOne(promptO, &otp); err != nil {
+			if err := AskOne(promptO, &otp, "--otp"); err != nil {
 				return err
 			}
 		}
 	case "ssh-key/certificate":
 		promptI = &survey.Input{Message: "SSH Key/Certificate Path (leave empty for auto-discovery in ~/.ssh and ssh-agent):"}
-		if err := survey.AskOne(promptI, &sshKey); err != nil {
+		if err := AskOne(promptI, &sshKey, "--ssh-key"); err != nil {
 			return err
 		}
 
 		if sshKey == "" {
-			sshKey, err = promptSelect("Select ssh-key: ", task.ListSSHPubkey(), "", "")
+			sshKey, err = promptSelect("Select ssh-key: ", "--ssh-key", task.ListSSHPubkey(), "", "")
 			if err != nil {
 				return err
 			}
@@ -129,12 +129,12 @@ func CreateLogin() error {
 		Message: "Set Optional settings: ",
 		Default: false,
 	}
-	if err = survey.AskOne(promptYN, &optSettings); err != nil {
+	if err = AskOne(promptYN, &optSettings, "--insecure, --helper or --no-version-check"); err != nil {
 		return err
 	}
 	if optSettings {
 		promptI = &survey.Input{Message: "SSH Key Path (leave empty for auto-discovery):"}
-		if err := survey.AskOne(promptI, &sshKey); err != nil {
+		if err := AskOne(promptI, &sshKey, "--ssh-key"); err != nil {
 			return err
 		}
 
@@ -142,7 +142,7 @@ func CreateLogin() error {
 			Message: "Allow Insecure connections: ",
 			Default: false,
 		}
-		if err = survey.AskOne(promptYN, &insecure); err != nil {
+		if err = AskOne(promptYN, &insecure, "--insecure"); err != nil {
 			return err
 		}
 
@@ -150,7 +150,7 @@ func CreateLogin() error {
 			Message: "Add git helper: ",
 			Default: false,
 		}
-		if err = survey.AskOne(promptYN, &helper); err != nil {
+		if err = AskOne(promptYN, &helper, "--helper"); err != nil {
 			return err
 		}
 
@@ -158,7 +158,7 @@ func CreateLogin() error {
 			Message: "Check version of Gitea instance: ",
 			Default: true,
 		}
-		if err = survey.AskOne(promptYN, &versionCheck); err != nil {
+		if err = AskOne(promptYN, &versionCheck, "--no-version-check"); err != nil {
 			return err
 		}
 
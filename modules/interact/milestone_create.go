@@ -27,7 +27,7 @@ func CreateMilestone(login *config.Login, owner, repo string) error {
 	// title
 	promptOpts := survey.WithValidator(survey.Required)
 	promptI := &survey.Input{Message: "Milestone title:"}
-	if err := survey.AskOne(promptI, &title, promptOpts); err != nil {
+	if err := AskOne(promptI, &title, "--title", promptOpts); err != nil {
 		return err
 	}
 
@@ -37,12 +37,12 @@ func CreateMilestone(login *config.Login, owner, repo string) error {
 		Syntax:    "md",
 		UseEditor: config.GetPreferences().Editor,
 	})
-	if err := survey.AskOne(promptM, &description); err != nil {
+	if err := AskOne(promptM, &description, "--description"); err != nil {
 		return err
 	}
 
 	// deadline
-	if deadline, err = promptDatetime("Milestone deadline:"); err != nil {
+	if deadline, err = promptDatetime("Milestone deadline:", "--deadline"); err != nil {
 		return err
 	}
 
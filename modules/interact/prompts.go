@@ -36,8 +36,30 @@ func NewMultiline(opts Multiline) (prompt survey.Prompt) {
 	return
 }
 
+// AskOne wraps survey.AskOne. When prompting is disabled via --no-input, it
+// doesn't prompt, but returns an error naming the flag providing the value instead.
+func AskOne(p survey.Prompt, response interface{}, flag string, opts ...survey.AskOpt) error {
+	if utils.NoInput() {
+		return utils.NoInputError(flag)
+	}
+	return survey.AskOne(p, response, opts...)
+}
+
+// Confirm asks the user to confirm a destructive action, unless --yes was given.
+func Confirm(message string) (bool, error) {
+	if utils.AssumeYes() {
+		return true, nil
+	}
+	var confirmed bool
+	err := AskOne(&survey.Confirm{Message: message, Default: false}, &confirmed, "--yes")
+	return confirmed, err
+}
+
 // PromptPassword asks for a password and blocks until input was made.
 func PromptPassword(name string) (pass string, err error) {
+	if utils.NoInput() {
+		return "", fmt.Errorf("%s password required, but prompting is disabled by --no-input", name)
+	}
 	promptPW := &survey.Password{Message: name + " password:"}
 	err = survey.AskOne(promptPW, &pass, survey.WithValidator(survey.Required))
 	return
@@ -57,12 +79,18 @@ func promptRepoSlug(defaultOwner, defaultRepo string) (owner, repo string, err e
 	owner = defaultOwner
 	repo = defaultRepo
 
-	err = survey.AskOne(
+	// the repo isn't missing when it is known from context
+	if !required && utils.NoInput() {
+		return
+	}
+
+	err = AskOne(
 		&survey.Input{
 			Message: prompt,
 			Default: defaultVal,
 		},
 		&repoSlug,
+		"--repo",
 		survey.WithValidator(func(input interface{}) error {
 			if str, ok := input.(string); ok {
 				if !required && len(str) == 0 {
@@ -89,11 +117,12 @@ func promptRepoSlug(defaultOwner, defaultRepo string) (owner, repo string, err e
 
 // promptDatetime prompts for a date or datetime string.
 // Supports all formats understood by araddon/dateparse.
-func promptDatetime(prompt string) (val *time.Time, err error) {
+func promptDatetime(prompt, flag string) (val *time.Time, err error) {
 	var input string
-	err = survey.AskOne(
+	err = AskOne(
 		&survey.Input{Message: prompt},
 		&input,
+		flag,
 		survey.WithValidator(func(input interface{}) error {
 			if str, ok := input.(string); ok {
 				if len(str) == 0 {
@@ -114,21 +143,22 @@ func promptDatetime(prompt string) (val *time.Time, err error) {
 }
 
 // promptSelect creates a generic multiselect prompt, with processing of custom values.
-func promptMultiSelect(prompt string, options []string, customVal string) ([]string, error) {
+func promptMultiSelect(prompt, flag string, options, defaults []string, customVal string) ([]string, error) {
 	var selection []string
 	promptA := &survey.MultiSelect{
 		Message: prompt,
 		Options: makeSelectOpts(options, customVal, ""),
 		VimMode: true,
+		Default: filterSelectOpts(defaults, options),
 	}
-	if err := survey.AskOne(promptA, &selection); err != nil {
+	if err := AskOne(promptA, &selection, flag); err != nil {
 		return nil, err
 	}
-	return promptCustomVal(prompt, customVal, selection)
+	return promptCustomVal(prompt, flag, customVal, selection)
 }
 
 // promptSelectV2 creates a generic select prompt
-func promptSelectV2(prompt string, options []string) (string, error) {
+func promptSelectV2(prompt, flag string, options []string) (string, error) {
 	if len(options) == 0 {
 		return "", nil
 	}
@@ -139,14 +169,14 @@ func promptSelectV2(prompt string, options []string) (string, error) {
 		VimMode: true,
 		Default: options[0],
 	}
-	if err := survey.AskOne(promptA, &selection); err != nil {
+	if err := AskOne(promptA, &selection, flag); err != nil {
 		return "", err
 	}
 	return selection, nil
 }
 
 // promptSelect creates a generic select prompt, with processing of custom values or none-option.
-func promptSelect(prompt string, options []string, customVal, noneVal string) (string, error) {
+func promptSelect(prompt, flag string, options []string, customVal, noneVal string) (string, error) {
 	var selection string
 	promptA := &survey.Select{
 		Message: prompt,
@@ -154,14 +184,14 @@ func promptSelect(prompt string, options []string, customVal, noneVal string) (s
 		VimMode: true,
 		Default: noneVal,
 	}
-	if err := survey.AskOne(promptA, &selection); err != nil {
+	if err := AskOne(promptA, &selection, flag); err != nil {
 		return "", err
 	}
 	if noneVal != "" && selection == noneVal {
 		return "", nil
 	}
 	if customVal != "" {
-		sel, err := promptCustomVal(prompt, customVal, []string{selection})
+		sel, err := promptCustomVal(prompt, flag, customVal, []string{selection})
 		if err != nil {
 			return "", err
 		}
@@ -181,15 +211,27 @@ func makeSelectOpts(opts []string, customVal, noneVal string) []string {
 	return opts
 }
 
+// filterSelectOpts returns the values that are available in opts, as survey
+// fails on defaults that are no option.
+func filterSelectOpts(values, opts []string) []string {
+	var filtered []string
+	for _, v := range values {
+		if utils.Contains(opts, v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
 // promptCustomVal checks if customVal is present in selection, and prompts
 // for custom input to add to the selection instead.
-func promptCustomVal(prompt, customVal string, selection []string) ([]string, error) {
+func promptCustomVal(prompt, flag, customVal string, selection []string) ([]string, error) {
 	// check for custom value & prompt again with text input
 	// HACK until https://github.com/AlecAivazis/survey/issues/339 is implemented
 	if otherIndex := utils.IndexOf(selection, customVal); otherIndex != -1 {
 		var customAssignees string
 		promptA := &survey.Input{Message: prompt, Help: "comma separated list"}
-		if err := survey.AskOne(promptA, &customAssignees); err != nil {
+		if err := AskOne(promptA, &customAssignees, flag); err != nil {
 			return nil, err
 		}
 		selection = append(selection[:otherIndex], selection[otherIndex+1:]...)
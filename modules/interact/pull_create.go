@@ -28,7 +28,7 @@ func CreatePull(ctx *context.TeaContext) (err error) {
 		return err
 	}
 	promptI := &survey.Input{Message: "Target branch:", Default: base}
-	if err := survey.AskOne(promptI, &base); err != nil {
+	if err := AskOne(promptI, &base, "--base"); err != nil {
 		return err
 	}
 
@@ -43,23 +43,35 @@ func CreatePull(ctx *context.TeaContext) (err error) {
 		}
 	}
 	promptI = &survey.Input{Message: "Source repo owner:", Default: headOwner}
-	if err := survey.AskOne(promptI, &headOwner); err != nil {
+	if err := AskOne(promptI, &headOwner, "--head"); err != nil {
 		return err
 	}
 	promptI = &survey.Input{Message: "Source branch:", Default: headBranch}
-	if err := survey.AskOne(promptI, &headBranch, promptOpts); err != nil {
+	if err := AskOne(promptI, &headBranch, "--head", promptOpts); err != nil {
 		return err
 	}
 
 	promptC := &survey.Confirm{Message: "Allow Maintainers to push to the base branch", Default: true}
-	if err := survey.AskOne(promptC, &allowMaintainerEdits); err != nil {
+	if err := AskOne(promptC, &allowMaintainerEdits, "--allow-maintainer-edits"); err != nil {
 		return err
 	}
 
 	head = task.GetHeadSpec(headOwner, headBranch, ctx.Owner)
 
+	templates, err := findTemplates(ctx, task.FindPullTemplates)
+	if err != nil {
+		return err
+	}
+	var tmpl *task.IssueTemplate
+	if len(templates) == 1 && !ctx.IsSet("template") {
+		// a single PR template is applied without asking, like in the web UI
+		tmpl = templates[0]
+	} else if tmpl, err = promptTemplate(ctx, templates); err != nil {
+		return err
+	}
+
 	opts := gitea.CreateIssueOption{Title: task.GetDefaultPRTitle(head)}
-	if err = promptIssueProperties(ctx.Login, ctx.Owner, ctx.Repo, &opts); err != nil {
+	if err = promptIssueProperties(ctx.Login, ctx.Owner, ctx.Repo, &opts, tmpl); err != nil {
 		return err
 	}
 
@@ -15,8 +15,8 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 )
 
-// MergePull interactively creates a PR
-func MergePull(ctx *context.TeaContext) error {
+// MergePull interactively selects a PR to merge with opt
+func MergePull(ctx *context.TeaContext, opt gitea.MergePullRequestOption) error {
 	if ctx.LocalRepo == nil {
 		return fmt.Errorf("Must specify a PR index")
 	}
@@ -31,11 +31,7 @@ func MergePull(ctx *context.TeaContext) error {
 		return err
 	}
 
-	return task.PullMerge(ctx.Login, ctx.Owner, ctx.Repo, idx, gitea.MergePullRequestOption{
-		Style:   gitea.MergeStyle(ctx.String("style")),
-		Title:   ctx.String("title"),
-		Message: ctx.String("message"),
-	})
+	return task.PullMerge(ctx.Login, ctx.Owner, ctx.Repo, idx, opt)
 }
 
 // getPullIndex interactively determines the PR index
@@ -81,7 +77,7 @@ func getPullIndex(ctx *context.TeaContext, branch string) (int64, error) {
 			Options:  prOptions,
 			PageSize: 10,
 		}
-		err = survey.AskOne(q, &selected)
+		err = AskOne(q, &selected, "<pull index>")
 		if err != nil {
 			return 0, err
 		}
@@ -92,11 +88,10 @@ func getPullIndex(ctx *context.TeaContext, branch string) (int64, error) {
 
 	// get the index from the selected option
 	before, _, _ := strings.Cut(selected, ":")
-	before = strings.TrimPrefix(before, "#")
-	idx, err := utils.ArgToIndex(before)
+	ref, err := utils.ParseIndexRef(before)
 	if err != nil {
 		return 0, err
 	}
 
-	return idx, nil
+	return ref.Index, nil
 }
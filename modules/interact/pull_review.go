@@ -10,6 +10,7 @@ import (
 	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/AlecAivazis/survey/v2"
@@ -29,6 +30,10 @@ func ReviewPull(ctx *context.TeaContext, idx int64) error {
 	var codeComments []gitea.CreatePullReviewComment
 	var err error
 
+	if utils.NoInput() {
+		return utils.NewInvalidArgumentErrorf("reviews are interactive, use 'tea pulls approve' or 'tea pulls reject' with --no-input")
+	}
+
 	// codeComments
 	var reviewDiff bool
 	promptDiff := &survey.Confirm{Message: "Review / comment the diff?", Default: true}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package interact
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/task"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// selectItem is an option of fuzzySelect
+type selectItem struct {
+	title   string // shown in the option list
+	preview string // shown next to the highlighted option, also matched by the filter
+}
+
+// checkSelectable returns an error if selecting interactively is not possible.
+// flag names the argument that has to be provided instead.
+func checkSelectable(flag string) error {
+	if utils.NoInput() {
+		return utils.NoInputError(flag)
+	}
+	if IsStdinPiped() {
+		return utils.NewInvalidArgumentErrorf("missing value for %s", flag)
+	}
+	return nil
+}
+
+// fuzzySelect lets the user pick one of items, filtered by typing a fuzzy pattern.
+// It returns the index of the selected item.
+func fuzzySelect(message, flag string, items []selectItem) (int, error) {
+	if err := checkSelectable(flag); err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, utils.NewNotExistErrorf("nothing to select for %s", flag)
+	}
+
+	options := make([]string, len(items))
+	for i, item := range items {
+		options[i] = item.title
+	}
+
+	var selected int
+	err := AskOne(&survey.Select{
+		Message:  message,
+		Options:  options,
+		PageSize: 10,
+		Description: func(_ string, i int) string {
+			return items[i].preview
+		},
+	}, &selected, flag, survey.WithFilter(func(filter, value string, i int) bool {
+		return fuzzyMatch(filter, value) || fuzzyMatch(filter, items[i].preview)
+	}))
+	return selected, err
+}
+
+// fuzzyMatch reports whether all runes of pattern occur in s in the same
+// order, ignoring case.
+func fuzzyMatch(pattern, s string) bool {
+	s = strings.ToLower(s)
+	for _, r := range strings.ToLower(pattern) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
+
+// previewText condenses a markdown body to a single line of limited length
+func previewText(body string) string {
+	const maxLen = 72
+	text := strings.Join(strings.Fields(body), " ")
+	if r := []rune(text); len(r) > maxLen {
+		text = string(r[:maxLen-1]) + "…"
+	}
+	return text
+}
+
+// SelectIssue interactively selects an issue or pull request of the given
+// state in the repo of ctx and returns its index.
+func SelectIssue(ctx *context.TeaContext, kind gitea.IssueType, state gitea.StateType) (int64, error) {
+	message, flag := "Select an issue:", "<issue index>"
+	switch kind {
+	case gitea.IssueTypePull:
+		message, flag = "Select a pull request:", "<pull index>"
+	case gitea.IssueTypeAll:
+		message, flag = "Select an issue or pull request:", "<index>"
+	}
+	if err := checkSelectable(flag); err != nil {
+		return 0, err
+	}
+
+	client := ctx.Login.Client()
+	issues, err := task.ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Issue, *gitea.Response, error) {
+		return client.ListRepoIssues(ctx.Owner, ctx.Repo, gitea.ListIssueOption{
+			ListOptions: opt,
+			State:       state,
+			Type:        kind,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	items := make([]selectItem, len(issues))
+	for i, issue := range issues {
+		items[i] = selectItem{
+			title:   fmt.Sprintf("#%d %s", issue.Index, issue.Title),
+			preview: previewText(issue.Body),
+		}
+	}
+	selected, err := fuzzySelect(message, flag, items)
+	if err != nil {
+		return 0, err
+	}
+	return issues[selected].Index, nil
+}
+
+// SelectMilestone interactively selects a milestone of the given state in the
+// repo of ctx and returns its name.
+func SelectMilestone(ctx *context.TeaContext, state gitea.StateType) (string, error) {
+	message, flag := "Select a milestone:", "<milestone name>"
+	if err := checkSelectable(flag); err != nil {
+		return "", err
+	}
+
+	client := ctx.Login.Client()
+	milestones, err := task.ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Milestone, *gitea.Response, error) {
+		return client.ListRepoMilestones(ctx.Owner, ctx.Repo, gitea.ListMilestoneOption{
+			ListOptions: opt,
+			State:       state,
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	items := make([]selectItem, len(milestones))
+	for i, m := range milestones {
+		items[i] = selectItem{title: m.Title, preview: previewText(m.Description)}
+	}
+	selected, err := fuzzySelect(message, flag, items)
+	if err != nil {
+		return "", err
+	}
+	return milestones[selected].Title, nil
+}
+
+// SelectRelease interactively selects a release in the repo of ctx and
+// returns its tag.
+func SelectRelease(ctx *context.TeaContext) (string, error) {
+	message, flag := "Select a release:", "<release tag>"
+	if err := checkSelectable(flag); err != nil {
+		return "", err
+	}
+
+	client := ctx.Login.Client()
+	releases, err := task.ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Release, *gitea.Response, error) {
+		return client.ListReleases(ctx.Owner, ctx.Repo, gitea.ListReleasesOptions{ListOptions: opt})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	items := make([]selectItem, len(releases))
+	for i, r := range releases {
+		items[i] = selectItem{
+			title:   fmt.Sprintf("%s %s", r.TagName, r.Title),
+			preview: previewText(r.Note),
+		}
+	}
+	selected, err := fuzzySelect(message, flag, items)
+	if err != nil {
+		return "", err
+	}
+	return releases[selected].TagName, nil
+}
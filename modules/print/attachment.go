@@ -4,6 +4,8 @@
 package print
 
 import (
+	"fmt"
+
 	"code.gitea.io/sdk/gitea"
 )
 
@@ -23,3 +25,31 @@ func ReleaseAttachmentsList(attachments []*gitea.Attachment, output string) {
 
 	t.print(output)
 }
+
+// AttachmentsList prints a listing of issue or comment attachments
+func AttachmentsList(attachments []*gitea.Attachment, output string) {
+	t := tableWithHeader(
+		"ID",
+		"Name",
+		"Size",
+		"Created",
+		"URL",
+	)
+
+	machineReadable := isMachineReadable(output)
+	for _, attachment := range attachments {
+		size := formatBytes(attachment.Size)
+		if machineReadable {
+			size = fmt.Sprintf("%d", attachment.Size)
+		}
+		t.addRow(
+			fmt.Sprintf("%d", attachment.ID),
+			attachment.Name,
+			size,
+			FormatTime(attachment.Created, machineReadable),
+			attachment.DownloadURL,
+		)
+	}
+
+	t.print(output)
+}
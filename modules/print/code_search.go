@@ -0,0 +1,74 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/modules/api"
+
+	"github.com/muesli/termenv"
+)
+
+// CodeSearchHitsList prints a listing of code search hits
+func CodeSearchHitsList(hits []*api.CodeSearchHit, output string, fields []string) {
+	printables := make([]printable, len(hits))
+	for i, h := range hits {
+		printables[i] = &printableCodeSearchHit{h}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// CodeSearchFields are all available fields to print with CodeSearchHitsList()
+var CodeSearchFields = []string{
+	"repo",
+	"path",
+	"line",
+	"snippet",
+	"url",
+}
+
+type printableCodeSearchHit struct {
+	*api.CodeSearchHit
+}
+
+func (x printableCodeSearchHit) FormatField(field string, machineReadable bool) string {
+	switch field {
+	case "repo":
+		return x.Repo
+	case "path":
+		return x.Path
+	case "line":
+		return fmt.Sprint(x.Line)
+	case "snippet":
+		return formatCodeSnippet(x.CodeSearchHit, !machineReadable && termenv.EnvColorProfile() != termenv.Ascii)
+	case "url":
+		return x.URL
+	}
+	return ""
+}
+
+// formatCodeSnippet returns the trimmed content of hit, with its matches in
+// bold if highlight is set
+func formatCodeSnippet(hit *api.CodeSearchHit, highlight bool) string {
+	content := hit.Content
+	var out strings.Builder
+	last := 0
+	for _, h := range hit.Highlights {
+		if h[0] < last || h[1] > len(content) || h[0] >= h[1] {
+			continue
+		}
+		out.WriteString(content[last:h[0]])
+		if highlight {
+			out.WriteString(termenv.String(content[h[0]:h[1]]).Bold().String())
+		} else {
+			out.WriteString(content[h[0]:h[1]])
+		}
+		last = h[1]
+	}
+	out.WriteString(content[last:])
+	return strings.TrimSpace(out.String())
+}
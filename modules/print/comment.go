@@ -10,8 +10,9 @@ import (
 	"code.gitea.io/sdk/gitea"
 )
 
-// Comments renders a list of comments to stdout
-func Comments(comments []*gitea.Comment) {
+// Comments renders a list of comments to stdout. reactions maps comment IDs
+// to the reactions on them, and may be nil.
+func Comments(comments []*gitea.Comment, reactions map[int64][]*gitea.Reaction) {
 	var baseURL string
 	if len(comments) != 0 {
 		baseURL = getRepoURL(comments[0].HTMLURL)
@@ -20,6 +21,9 @@ func Comments(comments []*gitea.Comment) {
 	out := make([]string, len(comments))
 	for i, c := range comments {
 		out[i] = formatComment(c)
+		if len(reactions[c.ID]) != 0 {
+			out[i] += fmt.Sprintf("\n%s\n", formatReactions(reactions[c.ID]))
+		}
 	}
 
 	_ = outputMarkdown(fmt.Sprintf(
@@ -47,3 +51,45 @@ func formatComment(c *gitea.Comment) string {
 		c.Body,
 	)
 }
+
+// CommentsList prints a listing of comments
+func CommentsList(comments []*gitea.Comment, output string, fields []string) {
+	printables := make([]printable, len(comments))
+	for i, c := range comments {
+		printables[i] = &printableComment{c}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// CommentFields are all available fields to print with CommentsList()
+var CommentFields = []string{
+	"id",
+	"author",
+	"created",
+	"updated",
+	"body",
+	"url",
+}
+
+type printableComment struct {
+	*gitea.Comment
+}
+
+func (x printableComment) FormatField(field string, machineReadable bool) string {
+	switch field {
+	case "id":
+		return fmt.Sprintf("%d", x.ID)
+	case "author":
+		return formatUserName(x.Poster)
+	case "created":
+		return FormatTime(x.Created, machineReadable)
+	case "updated":
+		return FormatTime(x.Updated, machineReadable)
+	case "body":
+		return x.Body
+	case "url":
+		return x.HTMLURL
+	}
+	return ""
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/muesli/termenv"
+)
+
+// DiffFile is the diff of a single file
+type DiffFile struct {
+	Path string
+	// OldPath differs from Path for renamed files
+	OldPath   string
+	Status    string
+	Additions int
+	Deletions int
+	Binary    bool
+	// Text is the diff of the file, including its header
+	Text string
+}
+
+// Diff prints the diffs of files, colored and syntax highlighted if color is
+// set, and paged if pager is set and stdout is a terminal
+func Diff(files []*DiffFile, color, pager bool) error {
+	var out strings.Builder
+	for _, f := range files {
+		out.WriteString(f.Text)
+	}
+	return DiffText(out.String(), color, pager)
+}
+
+// DiffText prints a diff or patch like Diff
+func DiffText(diff string, color, pager bool) error {
+	if !color {
+		return outputPaged(diff, pager)
+	}
+	return outputPaged(colorDiff(diff), pager)
+}
+
+// DiffStat prints the number of changed lines per file, like git diff --stat
+func DiffStat(files []*DiffFile, color, pager bool) error {
+	const maxBarWidth = 40
+	var pathWidth, maxChanges, additions, deletions int
+	for _, f := range files {
+		pathWidth = max(pathWidth, len(diffStatPath(f)))
+		maxChanges = max(maxChanges, f.Additions+f.Deletions)
+		additions += f.Additions
+		deletions += f.Deletions
+	}
+
+	var out strings.Builder
+	for _, f := range files {
+		if f.Binary {
+			fmt.Fprintf(&out, " %-*s | Bin\n", pathWidth, diffStatPath(f))
+			continue
+		}
+		plus, minus := f.Additions, f.Deletions
+		if maxChanges > maxBarWidth {
+			// scale the bar, but show at least one symbol for any change
+			plus = scaleStat(plus, maxChanges, maxBarWidth)
+			minus = scaleStat(minus, maxChanges, maxBarWidth)
+		}
+		bar := colorize(strings.Repeat("+", plus), termenv.ANSIGreen, color) +
+			colorize(strings.Repeat("-", minus), termenv.ANSIRed, color)
+		fmt.Fprintf(&out, " %-*s | %d %s\n", pathWidth, diffStatPath(f), f.Additions+f.Deletions, bar)
+	}
+	fmt.Fprintf(&out, " %d files changed, %d insertions(+), %d deletions(-)\n", len(files), additions, deletions)
+	return outputPaged(out.String(), pager)
+}
+
+// DiffNames prints the paths of files
+func DiffNames(files []*DiffFile) {
+	for _, f := range files {
+		fmt.Println(f.Path)
+	}
+}
+
+func diffStatPath(f *DiffFile) string {
+	if f.OldPath != "" && f.OldPath != f.Path {
+		return f.OldPath + " => " + f.Path
+	}
+	return f.Path
+}
+
+func scaleStat(n, total, width int) int {
+	if n == 0 {
+		return 0
+	}
+	return max(1, n*width/total)
+}
+
+// colorDiff colors the headers and +/- markers of a diff, and highlights the
+// syntax of the changed code by the language of each file
+func colorDiff(diff string) string {
+	var out strings.Builder
+	var lexer chroma.Lexer
+	inHunk := false
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		content := strings.TrimSuffix(line, "\n")
+		newline := line[len(content):]
+		switch {
+		case strings.HasPrefix(content, "diff --git "):
+			inHunk, lexer = false, nil
+			out.WriteString(termenv.String(content).Bold().String())
+		case !inHunk && strings.HasPrefix(content, "+++ "):
+			if path := strings.TrimPrefix(content[len("+++ "):], "b/"); path != "/dev/null" {
+				lexer = lexers.Match(path)
+			}
+			out.WriteString(termenv.String(content).Bold().String())
+		case !inHunk && strings.HasPrefix(content, "--- "):
+			if path := strings.TrimPrefix(content[len("--- "):], "a/"); path != "/dev/null" {
+				lexer = lexers.Match(path)
+			}
+			out.WriteString(termenv.String(content).Bold().String())
+		case strings.HasPrefix(content, "@@"):
+			inHunk = true
+			out.WriteString(colorize(content, termenv.ANSICyan, true))
+		case inHunk && strings.HasPrefix(content, "+"):
+			out.WriteString(colorize("+", termenv.ANSIGreen, true) + highlightCode(content[1:], lexer))
+		case inHunk && strings.HasPrefix(content, "-"):
+			out.WriteString(colorize("-", termenv.ANSIRed, true) + highlightCode(content[1:], lexer))
+		case inHunk && strings.HasPrefix(content, " "):
+			out.WriteString(" " + highlightCode(content[1:], lexer))
+		default:
+			out.WriteString(content)
+		}
+		out.WriteString(newline)
+	}
+	return out.String()
+}
+
+var (
+	codeStyle     *chroma.Style
+	codeStyleOnce sync.Once
+)
+
+// highlightCode colors a line of code by the syntax of lexer, leaving plain
+// text in the default color of the terminal. Lines are highlighted on their
+// own, so constructs spanning several lines may be colored wrongly.
+func highlightCode(code string, lexer chroma.Lexer) string {
+	if lexer == nil || code == "" {
+		return code
+	}
+	tokens, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return code
+	}
+	codeStyleOnce.Do(func() {
+		codeStyle = styles.Get("monokailight")
+		if termenv.HasDarkBackground() {
+			codeStyle = styles.Get("monokai")
+		}
+	})
+	profile := termenv.EnvColorProfile()
+	if profile == termenv.Ascii {
+		// color was requested for output which is no terminal
+		profile = termenv.ANSI
+	}
+	text := codeStyle.Get(chroma.Text).Colour
+
+	var out strings.Builder
+	for _, t := range tokens.Tokens() {
+		// lexers may append a newline to the last token
+		value := strings.TrimSuffix(t.Value, "\n")
+		colour := codeStyle.Get(t.Type).Colour
+		if !colour.IsSet() || colour == text {
+			out.WriteString(value)
+			continue
+		}
+		out.WriteString(profile.String(value).Foreground(profile.Color(colour.String())).String())
+	}
+	return out.String()
+}
+
+func colorize(text string, color termenv.ANSIColor, enabled bool) string {
+	if !enabled || text == "" {
+		return text
+	}
+	return termenv.String(text).Foreground(color).String()
+}
+
+// outputPaged prints text to stdout, through $PAGER (default "less -FRX")
+// if pager is set and stdout is a terminal
+func outputPaged(text string, pager bool) error {
+	if !pager || !IsInteractive() {
+		_, err := io.WriteString(os.Stdout, text)
+		return err
+	}
+	command := strings.Fields(os.Getenv("PAGER"))
+	if len(command) == 0 {
+		command = []string{"less", "-FRX"}
+	}
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		// the pager is not installed
+		_, err = io.WriteString(os.Stdout, text)
+		return err
+	}
+	return cmd.Wait()
+}
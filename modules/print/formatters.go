@@ -42,6 +42,14 @@ func formatSize(kb int64) string {
 	return fmt.Sprintf("%d Tb", gb/1024)
 }
 
+// formatBytes formats a size in bytes
+func formatBytes(b int64) string {
+	if b < 1024 {
+		return fmt.Sprintf("%d B", b)
+	}
+	return formatSize(b / 1024)
+}
+
 // FormatTime provides a string for the given time value.
 // If machineReadable is set, a UTC RFC3339 string is returned,
 // otherwise a simplified string in local time is used.
@@ -5,14 +5,27 @@ package print
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/enescakir/emoji"
 )
 
-// IssueDetails print an issue rendered to stdout
-func IssueDetails(issue *gitea.Issue, reactions []*gitea.Reaction) {
+// IssueExtras holds details of an issue that are not part of gitea.Issue,
+// and have to be fetched separately.
+type IssueExtras struct {
+	Pinned      bool
+	Subscribed  bool
+	Attachments []*gitea.Attachment
+	// issues blocking the issue, and issues blocked by it
+	BlockedBy []*gitea.Issue
+	Blocks    []*gitea.Issue
+}
+
+// IssueDetails print an issue rendered to stdout. extras may be nil.
+func IssueDetails(issue *gitea.Issue, reactions []*gitea.Reaction, extras *IssueExtras) {
 	out := fmt.Sprintf(
 		"# #%d %s (%s)\n@%s created %s\n\n%s\n",
 		issue.Index,
@@ -23,6 +36,18 @@ func IssueDetails(issue *gitea.Issue, reactions []*gitea.Reaction) {
 		issue.Body,
 	)
 
+	if flags := formatIssueFlags(issue.IsLocked, extras); len(flags) != 0 {
+		out += fmt.Sprintf("\n---\n\n%s\n", flags)
+	}
+
+	if extras != nil && (len(extras.BlockedBy) != 0 || len(extras.Blocks) != 0) {
+		baseRepo := ""
+		if issue.Repository != nil {
+			baseRepo = issue.Repository.FullName
+		}
+		out += "\n---\n" + formatIssueDependencies(extras.BlockedBy, extras.Blocks, baseRepo)
+	}
+
 	if len(reactions) > 0 {
 		out += fmt.Sprintf("\n---\n\n%s\n", formatReactions(reactions))
 	}
@@ -30,23 +55,80 @@ func IssueDetails(issue *gitea.Issue, reactions []*gitea.Reaction) {
 	_ = outputMarkdown(out, getRepoURL(issue.HTMLURL))
 }
 
+// formatIssueFlags summarizes the pin, lock & subscription state of an issue or pull.
+// extras may be nil.
+func formatIssueFlags(locked bool, extras *IssueExtras) string {
+	var flags []string
+	if extras != nil && extras.Pinned {
+		flags = append(flags, "📌 pinned")
+	}
+	if locked {
+		flags = append(flags, "🔒 locked")
+	}
+	if extras != nil && extras.Subscribed {
+		flags = append(flags, "🔔 subscribed")
+	}
+	if extras != nil && len(extras.Attachments) != 0 {
+		attachments := fmt.Sprintf("📎 %d attachments", len(extras.Attachments))
+		if len(extras.Attachments) == 1 {
+			attachments = "📎 1 attachment"
+		}
+		flags = append(flags, attachments)
+	}
+	return strings.Join(flags, "  |  ")
+}
+
+// formatReactions summarizes reactions by type, including the users who reacted
 func formatReactions(reactions []*gitea.Reaction) string {
-	reactionCounts := make(map[string]uint16)
+	var order []string
+	users := make(map[string][]string)
 	for _, r := range reactions {
-		reactionCounts[r.Reaction]++
+		if _, ok := users[r.Reaction]; !ok {
+			order = append(order, r.Reaction)
+		}
+		name := "ghost"
+		if r.User != nil {
+			name = r.User.UserName
+		}
+		users[r.Reaction] = append(users[r.Reaction], "@"+name)
 	}
 
-	reactionStrings := make([]string, 0, len(reactionCounts))
-	for reaction, count := range reactionCounts {
-		reactionStrings = append(reactionStrings, fmt.Sprintf("%dx :%s:", count, reaction))
+	reactionStrings := make([]string, 0, len(order))
+	for _, reaction := range order {
+		reactionStrings = append(reactionStrings, fmt.Sprintf("%dx :%s: (%s)",
+			len(users[reaction]), reaction, strings.Join(users[reaction], ", ")))
 	}
 
 	return emoji.Parse(strings.Join(reactionStrings, "  |  "))
 }
 
+// Reaction prints a reaction added to target, or removed from it if r is nil
+func Reaction(r *gitea.Reaction, reaction, target string) {
+	if r == nil {
+		fmt.Printf("Removed %s from %s\n", emoji.Parse(":"+reaction+":"), target)
+		return
+	}
+	name := "ghost"
+	if r.User != nil {
+		name = r.User.UserName
+	}
+	fmt.Printf("@%s reacted %s on %s\n", name, emoji.Parse(":"+r.Reaction+":"), target)
+}
+
 // IssuesPullsList prints a listing of issues & pulls
 func IssuesPullsList(issues []*gitea.Issue, output string, fields []string) {
-	printIssues(issues, output, fields)
+	fprintIssues(os.Stdout, issues, output, fields, nil)
+}
+
+// FprintIssuesPullsList prints a listing of issues & pulls to w
+func FprintIssuesPullsList(w io.Writer, issues []*gitea.Issue, output string, fields []string) {
+	fprintIssues(w, issues, output, fields, nil)
+}
+
+// IssuesPullsListWithExtras prints a listing of issues & pulls, including
+// fields of IssueExtras. extras maps issue IDs to their extras.
+func IssuesPullsListWithExtras(issues []*gitea.Issue, extras map[int64]*IssueExtras, output string, fields []string) {
+	fprintIssues(os.Stdout, issues, output, fields, extras)
 }
 
 // IssueFields are all available fields to print with IssuesList()
@@ -71,9 +153,13 @@ var IssueFields = []string{
 	"comments",
 	"owner",
 	"repo",
+	"slug",
+	"locked",
+	"pinned",
+	"subscribed",
 }
 
-func printIssues(issues []*gitea.Issue, output string, fields []string) {
+func fprintIssues(w io.Writer, issues []*gitea.Issue, output string, fields []string, extras map[int64]*IssueExtras) {
 	labelMap := map[int64]string{}
 	printables := make([]printable, len(issues))
 	machineReadable := isMachineReadable(output)
@@ -86,16 +172,17 @@ func printIssues(issues []*gitea.Issue, output string, fields []string) {
 			}
 		}
 		// store items with printable interface
-		printables[i] = &printableIssue{x, &labelMap}
+		printables[i] = &printableIssue{x, &labelMap, extras[x.ID]}
 	}
 
 	t := tableFromItems(fields, printables, machineReadable)
-	t.print(output)
+	t.fprint(w, output)
 }
 
 type printableIssue struct {
 	*gitea.Issue
 	formattedLabels *map[int64]string
+	extras          *IssueExtras
 }
 
 func (x printableIssue) FormatField(field string, machineReadable bool) string {
@@ -151,6 +238,14 @@ func (x printableIssue) FormatField(field string, machineReadable bool) string {
 		return x.Repository.Owner
 	case "repo":
 		return x.Repository.Name
+	case "slug":
+		return x.Repository.FullName
+	case "locked":
+		return formatBoolean(x.IsLocked, !machineReadable)
+	case "pinned":
+		return formatBoolean(x.extras != nil && x.extras.Pinned, !machineReadable)
+	case "subscribed":
+		return formatBoolean(x.extras != nil && x.extras.Subscribed, !machineReadable)
 	}
 	return ""
 }
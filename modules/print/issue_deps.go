@@ -0,0 +1,137 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// IssueRef formats a reference to issue, which is relative to baseRepo
+// (owner/repo) if the issue belongs to it.
+func IssueRef(issue *gitea.Issue, baseRepo string) string {
+	if issue.Repository != nil && !strings.EqualFold(issue.Repository.FullName, baseRepo) {
+		return fmt.Sprintf("%s#%d", issue.Repository.FullName, issue.Index)
+	}
+	return fmt.Sprintf("#%d", issue.Index)
+}
+
+// IssueDependencies prints the issues blocking an issue and the issues blocked by it
+func IssueDependencies(blockedBy, blocks []*gitea.Issue, baseRepo, output string) {
+	t := tableWithHeader("relation", "index", "title", "state")
+	for _, issue := range blockedBy {
+		t.addRow("blocked by", IssueRef(issue, baseRepo), issue.Title, string(issue.State))
+	}
+	for _, issue := range blocks {
+		t.addRow("blocks", IssueRef(issue, baseRepo), issue.Title, string(issue.State))
+	}
+	t.print(output)
+}
+
+// formatIssueDependencies renders the dependencies of an issue as markdown
+func formatIssueDependencies(blockedBy, blocks []*gitea.Issue, baseRepo string) string {
+	var out strings.Builder
+	for _, section := range []struct {
+		title  string
+		issues []*gitea.Issue
+	}{{"Blocked by", blockedBy}, {"Blocks", blocks}} {
+		if len(section.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&out, "\n**%s**\n\n", section.title)
+		for _, issue := range section.issues {
+			fmt.Fprintf(&out, "- %s %s (%s)\n", IssueRef(issue, baseRepo), issue.Title, issue.State)
+		}
+	}
+	return out.String()
+}
+
+// IssueDependencyTree prints the dependencies between issues as a text tree,
+// where children are blocked by their parent. blocks maps the full IssueRef
+// of an issue to the refs of the issues it blocks.
+func IssueDependencyTree(issues []*gitea.Issue, blocks map[string][]string, baseRepo string) {
+	byRef := make(map[string]*gitea.Issue, len(issues))
+	blocked := make(map[string]bool)
+	for _, issue := range issues {
+		byRef[IssueRef(issue, "")] = issue
+	}
+	for _, refs := range blocks {
+		for _, ref := range refs {
+			blocked[ref] = true
+		}
+	}
+
+	var out strings.Builder
+	printed := make(map[string]bool)
+	var printNode func(ref, indent, branch string)
+	printNode = func(ref, indent, branch string) {
+		issue := byRef[ref]
+		fmt.Fprintf(&out, "%s%s%s %s (%s)", indent, branch, IssueRef(issue, baseRepo), issue.Title, issue.State)
+		if printed[ref] {
+			// only print each subtree once, which also breaks cycles
+			if len(blocks[ref]) != 0 {
+				out.WriteString(" ...")
+			}
+			out.WriteString("\n")
+			return
+		}
+		out.WriteString("\n")
+		printed[ref] = true
+
+		switch branch {
+		case "├── ":
+			indent += "│   "
+		case "└── ":
+			indent += "    "
+		}
+		children := blocks[ref]
+		for i, child := range children {
+			if i == len(children)-1 {
+				printNode(child, indent, "└── ")
+			} else {
+				printNode(child, indent, "├── ")
+			}
+		}
+	}
+
+	// start with unblocked issues, then cover issues that are part of a cycle
+	for _, issue := range issues {
+		if ref := IssueRef(issue, ""); !blocked[ref] {
+			printNode(ref, "", "")
+		}
+	}
+	for _, issue := range issues {
+		if ref := IssueRef(issue, ""); !printed[ref] {
+			printNode(ref, "", "")
+		}
+	}
+	fmt.Print(out.String())
+}
+
+// IssueDependencyDot prints the dependencies between issues as a graphviz DOT
+// digraph, with edges pointing from blocking to blocked issues.
+func IssueDependencyDot(issues []*gitea.Issue, blocks map[string][]string, baseRepo string) {
+	var out strings.Builder
+	out.WriteString("digraph dependencies {\n\trankdir=LR;\n\tnode [shape=box];\n")
+	for _, issue := range issues {
+		label := fmt.Sprintf("%s %s", IssueRef(issue, baseRepo), issue.Title)
+		style := ""
+		if issue.State == gitea.StateClosed {
+			style = ", style=dashed, fontcolor=gray"
+		}
+		fmt.Fprintf(&out, "\t%s [label=%s, URL=%s%s];\n",
+			strconv.Quote(IssueRef(issue, "")), strconv.Quote(label), strconv.Quote(issue.HTMLURL), style)
+	}
+	for _, issue := range issues {
+		ref := IssueRef(issue, "")
+		for _, b := range blocks[ref] {
+			fmt.Fprintf(&out, "\t%s -> %s;\n", strconv.Quote(ref), strconv.Quote(b))
+		}
+	}
+	out.WriteString("}\n")
+	fmt.Print(out.String())
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// LoginItems are the items listed for a login, or the error listing them
+type LoginItems[T any] struct {
+	Login string
+	Items []T
+	Err   error
+}
+
+// loginPrintable adds the login field to a printable
+type loginPrintable struct {
+	printable
+	login string
+}
+
+func (x loginPrintable) FormatField(field string, machineReadable bool) string {
+	if field == "login" {
+		return x.login
+	}
+	return x.printable.FormatField(field, machineReadable)
+}
+
+// printLoginItems prints the items of all logins as a single table with a
+// login column, which is prepended unless fields contain it. Logins which
+// failed are reported on errOut, and an error is returned only if all of
+// them failed.
+func printLoginItems[T any](out, errOut io.Writer, listings []LoginItems[T], toPrintable func(T) printable, output string, fields []string) error {
+	var printables []printable
+	var lastErr error
+	failed := 0
+	for _, l := range listings {
+		if l.Err != nil {
+			failed, lastErr = failed+1, l.Err
+			fmt.Fprintf(errOut, "login '%s': %v\n", l.Login, l.Err)
+			continue
+		}
+		for _, item := range l.Items {
+			printables = append(printables, loginPrintable{toPrintable(item), l.Login})
+		}
+	}
+	if failed != 0 && failed == len(listings) {
+		return fmt.Errorf("listing failed for all %d logins: %w", failed, lastErr)
+	}
+	if !slices.Contains(fields, "login") {
+		fields = append([]string{"login"}, fields...)
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.fprint(out, output)
+	return nil
+}
+
+// NotificationsListPerLogin prints notifications of several logins, see NotificationsList
+func NotificationsListPerLogin(listings []LoginItems[*gitea.NotificationThread], output string, fields []string) error {
+	return printLoginItems(os.Stdout, os.Stderr, listings, func(n *gitea.NotificationThread) printable {
+		return &printableNotification{n}
+	}, output, fields)
+}
+
+// ReposListPerLogin prints repos of several logins, see ReposList
+func ReposListPerLogin(listings []LoginItems[*gitea.Repository], output string, fields []string) error {
+	return printLoginItems(os.Stdout, os.Stderr, listings, func(r *gitea.Repository) printable {
+		return &printableRepo{r}
+	}, output, fields)
+}
+
+// PullsListPerLogin prints pull requests of several logins, see PullsListWithExtras
+func PullsListPerLogin(listings []LoginItems[*gitea.PullRequest], extras map[*gitea.PullRequest]*PullExtras, output string, fields []string) error {
+	labelMap := map[int64]string{}
+	machineReadable := isMachineReadable(output)
+	return printLoginItems(os.Stdout, os.Stderr, listings, func(pr *gitea.PullRequest) printable {
+		for _, label := range pr.Labels {
+			if _, ok := labelMap[label.ID]; !ok {
+				labelMap[label.ID] = formatLabel(label, !machineReadable, "")
+			}
+		}
+		return &printablePull{pr, &labelMap, extras[pr]}
+	}, output, fields)
+}
+
+// TrackedTimesListPerLogin prints tracked times of several logins, see TrackedTimesList
+func TrackedTimesListPerLogin(listings []LoginItems[*gitea.TrackedTime], output string, fields []string) error {
+	return printLoginItems(os.Stdout, os.Stderr, listings, func(t *gitea.TrackedTime) printable {
+		return &printableTrackedTime{t, output}
+	}, output, fields)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintLoginItems(t *testing.T) {
+	listings := []LoginItems[*gitea.Repository]{
+		{Login: "work", Items: []*gitea.Repository{{Name: "tea"}, {Name: "sdk"}}},
+		{Login: "broken", Err: errors.New("unauthorized")},
+		{Login: "home", Items: []*gitea.Repository{{Name: "dotfiles"}}},
+	}
+	toPrintable := func(r *gitea.Repository) printable { return &printableRepo{r} }
+
+	tests := []struct {
+		name   string
+		fields []string
+		want   string
+	}{
+		{name: "login prepended", fields: []string{"name"}, want: `"login","name"
+"work","tea"
+"work","sdk"
+"home","dotfiles"
+`},
+		{name: "login selected", fields: []string{"name", "login"}, want: `"name","login"
+"tea","work"
+"sdk","work"
+"dotfiles","home"
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			err := printLoginItems(&out, &errOut, listings, toPrintable, "csv", tt.fields)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, out.String())
+			assert.Equal(t, "login 'broken': unauthorized\n", errOut.String())
+		})
+	}
+
+	var out, errOut bytes.Buffer
+	err := printLoginItems(&out, &errOut, listings[1:2], toPrintable, "csv", []string{"name"})
+	assert.EqualError(t, err, "listing failed for all 1 logins: unauthorized")
+	assert.Empty(t, out.String())
+}
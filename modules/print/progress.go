@@ -0,0 +1,23 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// Progress reports the progress of a bulk operation on stderr, overwriting
+// the previous report. Nothing is printed if stderr is no terminal.
+func Progress(label string, done, total int) {
+	if !term.IsTerminal(int(os.Stderr.Fd())) {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "\r%s %d/%d", label, done, total)
+	if done == total {
+		fmt.Fprintln(os.Stderr)
+	}
+}
@@ -19,7 +19,7 @@ var ciStatusSymbols = map[gitea.StatusState]string{
 }
 
 // PullDetails print an pull rendered to stdout
-func PullDetails(pr *gitea.PullRequest, reviews []*gitea.PullReview, ciStatus *gitea.CombinedStatus) {
+func PullDetails(pr *gitea.PullRequest, reviews []*gitea.PullReview, ciStatus *gitea.CombinedStatus, reactions []*gitea.Reaction, extras *IssueExtras) {
 	base := pr.Base.Name
 	head := formatPRHead(pr)
 	state := formatPRState(pr)
@@ -67,6 +67,14 @@ func PullDetails(pr *gitea.PullRequest, reviews []*gitea.PullReview, ciStatus *g
 		out += "- Maintainers are allowed to edit\n"
 	}
 
+	if flags := formatIssueFlags(pr.IsLocked, extras); len(flags) != 0 {
+		out += fmt.Sprintf("\n---\n\n%s\n", flags)
+	}
+
+	if len(reactions) > 0 {
+		out += fmt.Sprintf("\n---\n\n%s\n", formatReactions(reactions))
+	}
+
 	outputMarkdown(out, getRepoURL(pr.HTMLURL))
 }
 
@@ -138,9 +146,23 @@ func formatReviews(pr *gitea.PullRequest, reviews []*gitea.PullReview) string {
 	return result
 }
 
+// PullExtras holds details of a pull request that are not part of
+// gitea.PullRequest, and have to be fetched separately.
+type PullExtras struct {
+	// CI is the combined commit status of the head, nil if there is none
+	CI      *gitea.CombinedStatus
+	Reviews []*gitea.PullReview
+}
+
 // PullsList prints a listing of pulls
 func PullsList(prs []*gitea.PullRequest, output string, fields []string) {
-	printPulls(prs, output, fields)
+	printPulls(prs, output, fields, nil)
+}
+
+// PullsListWithExtras prints a listing of pulls, including fields of
+// PullExtras. extras maps pull requests to their extras.
+func PullsListWithExtras(prs []*gitea.PullRequest, extras map[*gitea.PullRequest]*PullExtras, output string, fields []string) {
+	printPulls(prs, output, fields, extras)
 }
 
 // PullFields are all available fields to print with PullsList()
@@ -155,6 +177,9 @@ var PullFields = []string{
 	"body",
 
 	"mergeable",
+	"conflicts",
+	"ci",
+	"reviews",
 	"base",
 	"base-commit",
 	"head",
@@ -171,7 +196,7 @@ var PullFields = []string{
 	"comments",
 }
 
-func printPulls(pulls []*gitea.PullRequest, output string, fields []string) {
+func printPulls(pulls []*gitea.PullRequest, output string, fields []string, extras map[*gitea.PullRequest]*PullExtras) {
 	labelMap := map[int64]string{}
 	var printables = make([]printable, len(pulls))
 	machineReadable := isMachineReadable(output)
@@ -184,7 +209,7 @@ func printPulls(pulls []*gitea.PullRequest, output string, fields []string) {
 			}
 		}
 		// store items with printable interface
-		printables[i] = &printablePull{x, &labelMap}
+		printables[i] = &printablePull{x, &labelMap, extras[x]}
 	}
 
 	t := tableFromItems(fields, printables, machineReadable)
@@ -194,6 +219,7 @@ func printPulls(pulls []*gitea.PullRequest, output string, fields []string) {
 type printablePull struct {
 	*gitea.PullRequest
 	formattedLabels *map[int64]string
+	extras          *PullExtras
 }
 
 func (x printablePull) FormatField(field string, machineReadable bool) string {
@@ -243,6 +269,22 @@ func (x printablePull) FormatField(field string, machineReadable bool) string {
 	case "mergeable":
 		isMergeable := x.Mergeable && x.State == gitea.StateOpen
 		return formatBoolean(isMergeable, !machineReadable)
+	case "conflicts":
+		hasConflicts := !x.Mergeable && x.State == gitea.StateOpen
+		return formatBoolean(hasConflicts, !machineReadable)
+	case "ci":
+		if x.extras == nil || x.extras.CI == nil {
+			return ""
+		}
+		if machineReadable {
+			return string(x.extras.CI.State)
+		}
+		return ciStatusSymbols[x.extras.CI.State] + string(x.extras.CI.State)
+	case "reviews":
+		if x.extras == nil {
+			return ""
+		}
+		return ReviewSummary(x.extras.Reviews)
 	case "base":
 		return x.Base.Ref
 	case "base-commit":
@@ -0,0 +1,87 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// PullCheck is the latest state of a commit status context of a pull request
+type PullCheck struct {
+	Context     string
+	State       gitea.StatusState
+	Description string
+	URL         string
+	// Required is set for checks required by branch protection
+	Required bool
+	// Started is when the first status of the context was created, Finished
+	// when the latest was updated, zero for pending checks
+	Started  time.Time
+	Finished time.Time
+}
+
+// Duration returns how long the check ran, or has been running if pending
+func (c *PullCheck) Duration() time.Duration {
+	if c.Started.IsZero() {
+		return 0
+	}
+	if c.Finished.IsZero() {
+		return time.Since(c.Started)
+	}
+	return c.Finished.Sub(c.Started)
+}
+
+// PullChecksList prints a listing of checks
+func PullChecksList(checks []*PullCheck, output string, fields []string) {
+	printables := make([]printable, len(checks))
+	for i, c := range checks {
+		printables[i] = &printablePullCheck{c}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// PullCheckFields are all available fields to print with PullChecksList()
+var PullCheckFields = []string{
+	"context",
+	"state",
+	"description",
+	"url",
+	"duration",
+	"required",
+}
+
+type printablePullCheck struct {
+	*PullCheck
+}
+
+func (x printablePullCheck) FormatField(field string, machineReadable bool) string {
+	switch field {
+	case "context":
+		return x.Context
+	case "state":
+		if machineReadable {
+			return string(x.State)
+		}
+		return ciStatusSymbols[x.State] + string(x.State)
+	case "description":
+		return x.Description
+	case "url":
+		return x.URL
+	case "duration":
+		if x.Started.IsZero() {
+			return ""
+		}
+		if machineReadable {
+			return fmt.Sprint(int64(x.Duration().Seconds()))
+		}
+		return x.Duration().Round(time.Second).String()
+	case "required":
+		return formatBoolean(x.Required, !machineReadable)
+	}
+	return ""
+}
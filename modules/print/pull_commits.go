@@ -0,0 +1,146 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// PullCommitsList prints a listing of the commits of a pull request
+func PullCommitsList(commits []*gitea.Commit, output string, fields []string) {
+	printables := make([]printable, len(commits))
+	for i, c := range commits {
+		printables[i] = &printableCommit{c}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// PullCommitFields are all available fields to print with PullCommitsList()
+var PullCommitFields = []string{
+	"sha",
+	"author",
+	"author-email",
+	"author-login",
+	"date",
+	"subject",
+	"message",
+	"verified",
+	"verification",
+	"url",
+}
+
+type printableCommit struct {
+	*gitea.Commit
+}
+
+func (x printableCommit) FormatField(field string, machineReadable bool) string {
+	var author *gitea.CommitUser
+	var message string
+	var verification *gitea.PayloadCommitVerification
+	if x.RepoCommit != nil {
+		author = x.RepoCommit.Author
+		message = x.RepoCommit.Message
+		verification = x.RepoCommit.Verification
+	}
+
+	switch field {
+	case "sha":
+		if x.CommitMeta == nil {
+			return ""
+		}
+		if !machineReadable && len(x.SHA) > 10 {
+			return x.SHA[:10]
+		}
+		return x.SHA
+	case "author":
+		if author == nil {
+			return ""
+		}
+		return author.Name
+	case "author-email":
+		if author == nil {
+			return ""
+		}
+		return author.Email
+	case "author-login":
+		if x.Author == nil {
+			return ""
+		}
+		return x.Author.UserName
+	case "date":
+		if author == nil {
+			return ""
+		}
+		date, err := time.Parse(time.RFC3339, author.Date)
+		if err != nil {
+			return author.Date
+		}
+		return FormatTime(date, machineReadable)
+	case "subject":
+		subject, _, _ := strings.Cut(message, "\n")
+		return subject
+	case "message":
+		return strings.TrimSpace(message)
+	case "verified":
+		return formatBoolean(verification != nil && verification.Verified, !machineReadable)
+	case "verification":
+		if verification == nil {
+			return ""
+		}
+		return verification.Reason
+	case "url":
+		return x.HTMLURL
+	}
+	return ""
+}
+
+// PullFilesList prints a listing of the files changed by a pull request
+func PullFilesList(files []*gitea.ChangedFile, output string, fields []string) {
+	printables := make([]printable, len(files))
+	for i, f := range files {
+		printables[i] = &printableChangedFile{f}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// PullFileFields are all available fields to print with PullFilesList()
+var PullFileFields = []string{
+	"path",
+	"previous-path",
+	"status",
+	"additions",
+	"deletions",
+	"changes",
+	"url",
+}
+
+type printableChangedFile struct {
+	*gitea.ChangedFile
+}
+
+func (x printableChangedFile) FormatField(field string, machineReadable bool) string {
+	switch field {
+	case "path":
+		return x.Filename
+	case "previous-path":
+		return x.PreviousFilename
+	case "status":
+		return x.Status
+	case "additions":
+		return fmt.Sprintf("%d", x.Additions)
+	case "deletions":
+		return fmt.Sprintf("%d", x.Deletions)
+	case "changes":
+		return fmt.Sprintf("%d", x.Changes)
+	case "url":
+		return x.HTMLURL
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintableCommit(t *testing.T) {
+	c := printableCommit{&gitea.Commit{
+		CommitMeta: &gitea.CommitMeta{SHA: "0123456789abcdef0123456789abcdef01234567"},
+		HTMLURL:    "https://example.com/org/app/commit/0123456789",
+		Author:     &gitea.User{UserName: "alice"},
+		RepoCommit: &gitea.RepoCommit{
+			Author:       &gitea.CommitUser{Identity: gitea.Identity{Name: "Alice", Email: "alice@example.com"}, Date: "2026-01-02T10:00:00Z"},
+			Message:      "Fix the parser\n\nIt failed on empty input.\n",
+			Verification: &gitea.PayloadCommitVerification{Verified: false, Reason: "gpg.error.not_signed_commit"},
+		},
+	}}
+
+	assert.Equal(t, "0123456789", c.FormatField("sha", false))
+	assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", c.FormatField("sha", true))
+	assert.Equal(t, "Alice", c.FormatField("author", true))
+	assert.Equal(t, "alice@example.com", c.FormatField("author-email", true))
+	assert.Equal(t, "alice", c.FormatField("author-login", true))
+	assert.Equal(t, FormatTime(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC), true), c.FormatField("date", true))
+	assert.Equal(t, "Fix the parser", c.FormatField("subject", true))
+	assert.Equal(t, "Fix the parser\n\nIt failed on empty input.", c.FormatField("message", true))
+	assert.Equal(t, "false", c.FormatField("verified", true))
+	assert.Equal(t, "gpg.error.not_signed_commit", c.FormatField("verification", true))
+	assert.Equal(t, "https://example.com/org/app/commit/0123456789", c.FormatField("url", true))
+
+	// commits of deleted users and unparsable dates
+	c.Author = nil
+	c.RepoCommit.Author.Date = "yesterday"
+	assert.Equal(t, "", c.FormatField("author-login", true))
+	assert.Equal(t, "yesterday", c.FormatField("date", true))
+
+	empty := printableCommit{&gitea.Commit{}}
+	for _, field := range PullCommitFields {
+		assert.NotPanics(t, func() { empty.FormatField(field, false) }, field)
+	}
+}
+
+func TestPrintableChangedFile(t *testing.T) {
+	f := printableChangedFile{&gitea.ChangedFile{
+		Filename:         "docs/b.md",
+		PreviousFilename: "docs/a.md",
+		Status:           "renamed",
+		Additions:        3,
+		Deletions:        1,
+		Changes:          4,
+		HTMLURL:          "https://example.com/org/app/src/commit/0123456789/docs/b.md",
+	}}
+
+	for field, expected := range map[string]string{
+		"path":          "docs/b.md",
+		"previous-path": "docs/a.md",
+		"status":        "renamed",
+		"additions":     "3",
+		"deletions":     "1",
+		"changes":       "4",
+		"url":           "https://example.com/org/app/src/commit/0123456789/docs/b.md",
+	} {
+		assert.Equal(t, expected, f.FormatField(field, true), field)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/modules/config"
+)
+
+// SavedSearchesList prints a listing of saved searches
+func SavedSearchesList(searches []config.SavedSearch, output string) {
+	t := tableWithHeader(
+		"Name",
+		"Command",
+		"Args",
+		"Shared",
+	)
+
+	for _, s := range searches {
+		t.addRow(
+			s.Name,
+			s.Command,
+			strings.Join(s.Args, " "),
+			fmt.Sprint(s.Shared),
+		)
+	}
+
+	t.print(output)
+}
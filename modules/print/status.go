@@ -0,0 +1,165 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// StatusItem is an issue, pull request or notification shown by Status
+type StatusItem struct {
+	Repo    string    `json:"repo"`
+	Index   int64     `json:"index,omitempty"`
+	Title   string    `json:"title"`
+	URL     string    `json:"url"`
+	Updated time.Time `json:"updated"`
+	// Type is the subject type of notifications
+	Type string `json:"type,omitempty"`
+	// CI and Review summarize the state of own pull requests
+	CI     string `json:"ci,omitempty"`
+	Review string `json:"review,omitempty"`
+}
+
+// UserStatus is everything that needs the user of a login
+type UserStatus struct {
+	Login           string        `json:"login"`
+	User            string        `json:"user"`
+	ReviewRequested []*StatusItem `json:"review_requested"`
+	OwnPulls        []*StatusItem `json:"own_pulls"`
+	Assigned        []*StatusItem `json:"assigned"`
+	Mentioned       []*StatusItem `json:"mentioned"`
+	Notifications   []*StatusItem `json:"notifications"`
+	// Errors of queries that failed, whose sections are incomplete
+	Errors []string `json:"errors,omitempty"`
+}
+
+// NewStatusItem returns the StatusItem of an issue or pull request
+func NewStatusItem(issue *gitea.Issue) *StatusItem {
+	item := &StatusItem{
+		Index:   issue.Index,
+		Title:   issue.Title,
+		URL:     issue.HTMLURL,
+		Updated: issue.Updated,
+	}
+	if issue.Repository != nil {
+		item.Repo = issue.Repository.FullName
+	}
+	return item
+}
+
+// NewNotificationStatusItem returns the StatusItem of a notification
+func NewNotificationStatusItem(n *gitea.NotificationThread) *StatusItem {
+	item := &StatusItem{Updated: n.UpdatedAt}
+	if n.Repository != nil {
+		item.Repo = n.Repository.FullName
+	}
+	if n.Subject != nil {
+		item.Title = n.Subject.Title
+		item.URL = n.Subject.HTMLURL
+		item.Type = string(n.Subject.Type)
+	}
+	return item
+}
+
+// ReviewSummary summarizes the latest reviews of each reviewer of a pull request
+func ReviewSummary(reviews []*gitea.PullReview) string {
+	latest := make(map[int64]*gitea.PullReview)
+	for _, r := range reviews {
+		if r.Reviewer == nil || r.Dismissed {
+			continue
+		}
+		if prev, ok := latest[r.Reviewer.ID]; !ok || r.Submitted.After(prev.Submitted) {
+			latest[r.Reviewer.ID] = r
+		}
+	}
+	var approved, changes, requested int
+	for _, r := range latest {
+		switch r.State {
+		case gitea.ReviewStateApproved:
+			approved++
+		case gitea.ReviewStateRequestChanges:
+			changes++
+		case gitea.ReviewStateRequestReview:
+			requested++
+		}
+	}
+	switch {
+	case changes != 0:
+		return "changes requested"
+	case approved != 0:
+		return fmt.Sprintf("%d approved", approved)
+	case requested != 0:
+		return "review pending"
+	}
+	return "no reviews"
+}
+
+// Status prints the status of one or more logins grouped by section, as
+// markdown or as JSON if output is "json".
+func Status(statuses []*UserStatus, output string) error {
+	if output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(statuses)
+	}
+
+	var out strings.Builder
+	for _, s := range statuses {
+		level := "#"
+		if len(statuses) > 1 {
+			fmt.Fprintf(&out, "# @%s on %s\n\n", s.User, s.Login)
+			level = "##"
+		}
+		for _, section := range []struct {
+			title string
+			items []*StatusItem
+		}{
+			{"Review requested", s.ReviewRequested},
+			{"My pull requests", s.OwnPulls},
+			{"Assigned issues", s.Assigned},
+			{"Mentions", s.Mentioned},
+			{"Unread notifications", s.Notifications},
+		} {
+			fmt.Fprintf(&out, "%s %s (%d)\n\n", level, section.title, len(section.items))
+			if len(section.items) == 0 {
+				out.WriteString("*nothing*\n\n")
+				continue
+			}
+			for _, item := range section.items {
+				out.WriteString(formatStatusItem(item))
+			}
+			out.WriteString("\n")
+		}
+		for _, err := range s.Errors {
+			fmt.Fprintf(&out, "> **error:** %s\n\n", err)
+		}
+	}
+	return outputMarkdown(out.String(), "")
+}
+
+func formatStatusItem(item *StatusItem) string {
+	ref := item.Repo
+	if item.Index != 0 {
+		ref = fmt.Sprintf("%s#%d", item.Repo, item.Index)
+	}
+	line := fmt.Sprintf("- [%s](%s) %s", ref, item.URL, item.Title)
+	var details []string
+	if item.Type != "" {
+		details = append(details, item.Type)
+	}
+	if item.CI != "" {
+		details = append(details, "CI: "+ciStatusSymbols[gitea.StatusState(item.CI)]+item.CI)
+	}
+	if item.Review != "" {
+		details = append(details, item.Review)
+	}
+	details = append(details, "updated "+FormatTime(item.Updated, false))
+	return fmt.Sprintf("%s *(%s)*\n", line, strings.Join(details, ", "))
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewSummary(t *testing.T) {
+	alice := &gitea.User{ID: 1, UserName: "alice"}
+	bob := &gitea.User{ID: 2, UserName: "bob"}
+	now := time.Now()
+
+	assert.Equal(t, "no reviews", ReviewSummary(nil))
+	assert.Equal(t, "2 approved", ReviewSummary([]*gitea.PullReview{
+		{Reviewer: alice, State: gitea.ReviewStateRequestChanges, Submitted: now.Add(-time.Hour)},
+		{Reviewer: alice, State: gitea.ReviewStateApproved, Submitted: now},
+		{Reviewer: bob, State: gitea.ReviewStateApproved, Submitted: now},
+	}))
+	assert.Equal(t, "changes requested", ReviewSummary([]*gitea.PullReview{
+		{Reviewer: alice, State: gitea.ReviewStateApproved, Submitted: now},
+		{Reviewer: bob, State: gitea.ReviewStateRequestChanges, Submitted: now},
+	}))
+	assert.Equal(t, "review pending", ReviewSummary([]*gitea.PullReview{
+		{Reviewer: bob, State: gitea.ReviewStateRequestChanges, Submitted: now, Dismissed: true},
+		{Reviewer: alice, State: gitea.ReviewStateRequestReview, Submitted: now},
+	}))
+}
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 
+	"code.gitea.io/tea/modules/utils"
+
 	"github.com/olekukonko/tablewriter"
 )
 
@@ -90,16 +92,23 @@ func (t *table) fprint(f io.Writer, output string) {
 	case "json":
 		outputJSON(f, t.headers, t.values)
 	default:
-		fmt.Fprintf(f, `"unknown output type '%s', available types are:
+		fmt.Fprintln(f, ValidateOutput(output))
+	}
+}
+
+// ValidateOutput returns an error if output is not a known output format
+func ValidateOutput(output string) error {
+	switch output {
+	case "", "table", "csv", "simple", "tsv", "yml", "yaml", "json":
+		return nil
+	}
+	return utils.NewInvalidArgumentErrorf(`unknown output type '%s', available types are:
 - csv: comma-separated values
 - simple: space-separated values
 - table: auto-aligned table format (default)
 - tsv: tab-separated values
 - yaml: YAML format
-- json: JSON format
-`, output)
-		os.Exit(1)
-	}
+- json: JSON format`, output)
 }
 
 // outputTable prints structured data as table
@@ -194,6 +203,11 @@ func outputJSON(f io.Writer, headers []string, values [][]string) {
 	fmt.Fprintln(f, "]")
 }
 
+// IsMachineReadable reports whether outputFormat is meant to be parsed by programs
+func IsMachineReadable(outputFormat string) bool {
+	return isMachineReadable(outputFormat)
+}
+
 func isMachineReadable(outputFormat string) bool {
 	switch outputFormat {
 	case "yml", "yaml", "csv", "tsv", "json":
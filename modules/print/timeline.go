@@ -0,0 +1,191 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/tea/modules/api"
+)
+
+// TimelineList prints a listing of timeline events. Issues referenced by the
+// events are shown relative to baseRepo (owner/repo).
+func TimelineList(events []*api.TimelineEvent, baseRepo, output string, fields []string) {
+	printables := make([]printable, len(events))
+	for i, e := range events {
+		printables[i] = &printableTimelineEvent{e, baseRepo}
+	}
+	t := tableFromItems(fields, printables, isMachineReadable(output))
+	t.print(output)
+}
+
+// TimelineFields are all available fields to print with TimelineList()
+var TimelineFields = []string{
+	"id",
+	"time",
+	"actor",
+	"event",
+	"details",
+	"url",
+}
+
+type printableTimelineEvent struct {
+	*api.TimelineEvent
+	baseRepo string
+}
+
+func (x printableTimelineEvent) FormatField(field string, machineReadable bool) string {
+	switch field {
+	case "id":
+		return fmt.Sprintf("%d", x.ID)
+	case "time":
+		return FormatTime(x.Created, machineReadable)
+	case "actor":
+		if x.User == nil {
+			return ""
+		}
+		return x.User.UserName
+	case "event":
+		return x.Type
+	case "details":
+		return describeTimelineEvent(x.TimelineEvent, x.baseRepo)
+	case "url":
+		return x.HTMLURL
+	}
+	return ""
+}
+
+// describeTimelineEvent summarizes what happened in the event in a single line
+func describeTimelineEvent(e *api.TimelineEvent, baseRepo string) string {
+	switch e.Type {
+	case "comment":
+		return "commented: " + firstLine(e.Body, 80)
+	case "close":
+		return "closed"
+	case "reopen":
+		return "reopened"
+	case "merge_pull":
+		return "merged"
+	case "issue_ref", "comment_ref", "pull_ref", "change_issue_ref":
+		if e.RefIssue != nil {
+			return "referenced this from " + IssueRef(e.RefIssue, baseRepo)
+		}
+		return "referenced this"
+	case "commit_ref":
+		sha := e.RefCommitSHA
+		if len(sha) > 10 {
+			sha = sha[:10]
+		}
+		return "referenced this in commit " + sha
+	case "label":
+		if e.Label == nil {
+			return "changed labels"
+		}
+		// the API marks added labels with a body of "1"
+		if e.Body == "1" {
+			return fmt.Sprintf("added label '%s'", e.Label.Name)
+		}
+		return fmt.Sprintf("removed label '%s'", e.Label.Name)
+	case "milestone":
+		switch {
+		case e.OldMilestone != nil && e.Milestone != nil:
+			return fmt.Sprintf("moved from milestone '%s' to '%s'", e.OldMilestone.Title, e.Milestone.Title)
+		case e.Milestone != nil:
+			return fmt.Sprintf("added to milestone '%s'", e.Milestone.Title)
+		case e.OldMilestone != nil:
+			return fmt.Sprintf("removed from milestone '%s'", e.OldMilestone.Title)
+		}
+		return "changed milestone"
+	case "assignees", "review_request":
+		var who string
+		switch {
+		case e.Assignee != nil:
+			who = "@" + e.Assignee.UserName
+		case e.AssigneeTeam != nil:
+			who = "team " + e.AssigneeTeam.Name
+		}
+		if e.Type == "review_request" {
+			if e.RemovedAssignee {
+				return "removed review request for " + who
+			}
+			return "requested review from " + who
+		}
+		if e.RemovedAssignee {
+			return "unassigned " + who
+		}
+		return "assigned " + who
+	case "change_title":
+		return fmt.Sprintf("changed title from '%s' to '%s'", e.OldTitle, e.NewTitle)
+	case "review", "code":
+		if e.Body != "" {
+			return "reviewed: " + firstLine(e.Body, 80)
+		}
+		return "reviewed"
+	case "dismiss_review":
+		return "dismissed a review"
+	case "delete_branch":
+		return fmt.Sprintf("deleted branch '%s'", e.OldRef)
+	case "change_target_branch":
+		return fmt.Sprintf("changed target branch from '%s' to '%s'", e.OldRef, e.NewRef)
+	case "pull_push":
+		var push struct {
+			IsForcePush bool     `json:"is_force_push"`
+			CommitIDs   []string `json:"commit_ids"`
+		}
+		if err := json.Unmarshal([]byte(e.Body), &push); err == nil {
+			if push.IsForcePush {
+				return "force-pushed"
+			}
+			return fmt.Sprintf("pushed %d commit(s)", len(push.CommitIDs))
+		}
+		return "pushed"
+	case "added_deadline", "modified_deadline", "removed_deadline":
+		verb := strings.TrimSuffix(e.Type, "_deadline")
+		// the body holds the new and old deadline, separated by '|'
+		deadline, _, _ := strings.Cut(e.Body, "|")
+		if e.Type == "removed_deadline" || deadline == "" {
+			return verb + " the due date"
+		}
+		return fmt.Sprintf("%s the due date %s", verb, deadline)
+	case "add_dependency", "remove_dependency":
+		verb := "added"
+		if e.Type == "remove_dependency" {
+			verb = "removed"
+		}
+		if e.DependentIssue != nil {
+			return fmt.Sprintf("%s dependency %s", verb, IssueRef(e.DependentIssue, baseRepo))
+		}
+		return verb + " a dependency"
+	case "lock":
+		if e.Body != "" {
+			return "locked as " + e.Body
+		}
+		return "locked"
+	case "unlock":
+		return "unlocked"
+	case "pin":
+		return "pinned"
+	case "unpin":
+		return "unpinned"
+	}
+	if e.Body != "" {
+		return fmt.Sprintf("%s: %s", e.Type, firstLine(e.Body, 80))
+	}
+	return strings.ReplaceAll(e.Type, "_", " ")
+}
+
+// firstLine returns the first line of s, shortened to maxLen runes
+func firstLine(s string, maxLen int) string {
+	line, _, more := strings.Cut(strings.TrimSpace(s), "\n")
+	line = strings.TrimSpace(line)
+	if r := []rune(line); len(r) > maxLen {
+		line, more = string(r[:maxLen]), true
+	}
+	if more {
+		line += "…"
+	}
+	return line
+}
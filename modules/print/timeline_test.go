@@ -0,0 +1,34 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package print
+
+import (
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeTimelineEvent(t *testing.T) {
+	ref := &gitea.Issue{Index: 3, Repository: &gitea.RepositoryMeta{FullName: "other/x"}}
+	local := &gitea.Issue{Index: 4, Repository: &gitea.RepositoryMeta{FullName: "zz/rr"}}
+
+	for expected, e := range map[string]*api.TimelineEvent{
+		"commented: first line…":                {Type: "comment", Body: "first line\nsecond"},
+		"added label 'bug'":                     {Type: "label", Body: "1", Label: &gitea.Label{Name: "bug"}},
+		"removed label 'bug'":                   {Type: "label", Label: &gitea.Label{Name: "bug"}},
+		"added to milestone '1.1'":              {Type: "milestone", Milestone: &gitea.Milestone{Title: "1.1"}},
+		"unassigned @alice":                     {Type: "assignees", Assignee: &gitea.User{UserName: "alice"}, RemovedAssignee: true},
+		"referenced this from other/x#3":        {Type: "issue_ref", RefIssue: ref},
+		"added dependency #4":                   {Type: "add_dependency", DependentIssue: local},
+		"pushed 2 commit(s)":                    {Type: "pull_push", Body: `{"commit_ids":["a","b"]}`},
+		"modified the due date 2026-01-02":      {Type: "modified_deadline", Body: "2026-01-02|2026-01-01"},
+		"changed title from 'a' to 'b'":         {Type: "change_title", OldTitle: "a", NewTitle: "b"},
+		"some new type":                         {Type: "some_new_type"},
+		"changed target branch from 'a' to 'b'": {Type: "change_target_branch", OldRef: "a", NewRef: "b"},
+	} {
+		assert.Equal(t, expected, describeTimelineEvent(e, "zz/rr"), e.Type)
+	}
+}
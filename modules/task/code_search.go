@@ -0,0 +1,206 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/tea/modules/api"
+	"code.gitea.io/tea/modules/config"
+
+	"golang.org/x/net/html"
+)
+
+// SearchCodeOption selects the repositories to search code in
+type SearchCodeOption struct {
+	Query string
+	// Owner and Repo select a single repository. If only Owner is set, all
+	// repositories of the user or organization are searched, and all
+	// repositories of the instance otherwise.
+	Owner string
+	Repo  string
+	Page  int
+}
+
+// CodeSearchURL returns the URL of the code search of the web interface
+func CodeSearchURL(login *config.Login, opt SearchCodeOption) string {
+	var scope string
+	switch {
+	case opt.Owner != "" && opt.Repo != "":
+		scope = url.PathEscape(opt.Owner) + "/" + url.PathEscape(opt.Repo) + "/search"
+	case opt.Owner != "":
+		scope = url.PathEscape(opt.Owner) + "/-/code"
+	default:
+		scope = "explore/code"
+	}
+	query := url.Values{"q": {opt.Query}}
+	if opt.Page > 1 {
+		query.Set("page", strconv.Itoa(opt.Page))
+	}
+	return fmt.Sprintf("%s/%s?%s", strings.TrimSuffix(login.URL, "/"), scope, query.Encode())
+}
+
+// SearchCode returns the lines of code matching opt.Query. The API provides
+// no code search, so the results page of the web interface is parsed, which
+// is rendered for anonymous users.
+func SearchCode(login *config.Login, opt SearchCodeOption) ([]*api.CodeSearchHit, error) {
+	var page bytes.Buffer
+	if err := login.Download(CodeSearchURL(login, opt), &page); err != nil {
+		return nil, fmt.Errorf("code search failed: %w", err)
+	}
+	hits, err := parseCodeSearchResults(&page, login.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse code search results: %w", err)
+	}
+	return hits, nil
+}
+
+// parseCodeSearchResults extracts the hits from a results page of the web
+// code search. Each result is a table of lines, whose line number links to
+// <repo>/src/commit/<sha>/<path>#L<line> and whose matches are marked with
+// the search-highlight class. Only lines with matches are returned, or the
+// first line of a result without any, as fuzzy matches aren't highlighted.
+func parseCodeSearchResults(r io.Reader, baseURL string) ([]*api.CodeSearchHit, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	base, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []*api.CodeSearchHit
+	var first *api.CodeSearchHit // first line of the current result
+	highlighted := false
+	flush := func() {
+		if first != nil && !highlighted {
+			hits = append(hits, first)
+		}
+		first, highlighted = nil, false
+	}
+
+	for _, row := range findElements(doc, "tr", "") {
+		link := findElements(row, "a", "")
+		code := findElements(row, "code", "code-inner")
+		if len(link) == 0 || len(code) == 0 {
+			continue
+		}
+		hit := parseCodeSearchLink(base, attr(link[0], "href"))
+		if hit == nil {
+			continue
+		}
+		hit.Content, hit.Highlights = highlightedText(code[0])
+
+		if first == nil || !strings.EqualFold(first.Repo, hit.Repo) || first.Path != hit.Path {
+			flush()
+			first = hit
+		}
+		if len(hit.Highlights) != 0 {
+			highlighted = true
+			hits = append(hits, hit)
+		}
+	}
+	flush()
+	return hits, nil
+}
+
+// parseCodeSearchLink parses the link to the line of a hit
+func parseCodeSearchLink(base *url.URL, href string) *api.CodeSearchHit {
+	u, err := base.Parse(href)
+	if err != nil {
+		return nil
+	}
+	rel, ok := strings.CutPrefix(u.EscapedPath(), base.EscapedPath())
+	if !ok {
+		return nil
+	}
+	repo, file, ok := strings.Cut(rel, "/src/commit/")
+	if !ok {
+		return nil
+	}
+	// skip the commit sha
+	_, file, ok = strings.Cut(file, "/")
+	if !ok {
+		return nil
+	}
+	line, err := strconv.Atoi(strings.TrimPrefix(u.Fragment, "L"))
+	if err != nil {
+		return nil
+	}
+	repo, _ = url.PathUnescape(repo)
+	file, _ = url.PathUnescape(file)
+	return &api.CodeSearchHit{Repo: repo, Path: file, Line: line, URL: u.String()}
+}
+
+// highlightedText returns the text of n, and the ranges of the text within
+// elements of the search-highlight class
+func highlightedText(n *html.Node) (string, [][2]int) {
+	var text strings.Builder
+	var highlights [][2]int
+	var walk func(n *html.Node, inHighlight bool)
+	walk = func(n *html.Node, inHighlight bool) {
+		if n.Type == html.TextNode {
+			start := text.Len()
+			text.WriteString(n.Data)
+			if inHighlight && text.Len() > start {
+				// merge adjacent ranges, as highlights may be split by syntax highlighting
+				if l := len(highlights); l != 0 && highlights[l-1][1] == start {
+					highlights[l-1][1] = text.Len()
+				} else {
+					highlights = append(highlights, [2]int{start, text.Len()})
+				}
+			}
+			return
+		}
+		inHighlight = inHighlight || hasClass(n, "search-highlight")
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, inHighlight)
+		}
+	}
+	walk(n, false)
+
+	content := strings.TrimRight(text.String(), "\r\n")
+	for i := range highlights {
+		highlights[i][1] = min(highlights[i][1], len(content))
+	}
+	return content, highlights
+}
+
+// findElements returns the elements below n with the given tag, which have
+// class if it's not empty
+func findElements(n *html.Node, tag, class string) []*html.Node {
+	var found []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == tag && (class == "" || hasClass(c, class)) {
+			found = append(found, c)
+			continue
+		}
+		found = append(found, findElements(c, tag, class)...)
+	}
+	return found
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"code.gitea.io/tea/modules/api"
+	"code.gitea.io/tea/modules/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const codeSearchPage = `<html><body><div class="repository search">
+<div class="diff-file-box diff-box file-content non-diff-file-content repo-search-result">
+	<h4 class="ui top attached normal header"><span class="file">main.go</span></h4>
+	<div class="file-body file-code code-view"><table><tbody>
+		<tr>
+			<td class="lines-num"><a href="/git/org/app/src/commit/abc123/cmd/main.go#L3"><span>3</span></a></td>
+			<td class="lines-code chroma"><code class="code-inner"><span class="kn">import</span> <span class="s">&#34;fmt&#34;</span>
+</code></td>
+		</tr>
+		<tr>
+			<td class="lines-num"><a href="/git/org/app/src/commit/abc123/cmd/main.go#L4"><span>4</span></a></td>
+			<td class="lines-code chroma"><code class="code-inner"><span class="kd">func</span> <span class="search-highlight"><span class="nf">ma</span><span class="nf">in</span></span>() {
+</code></td>
+		</tr>
+	</tbody></table></div>
+</div>
+<div class="diff-file-box diff-box file-content non-diff-file-content repo-search-result">
+	<div class="file-body file-code code-view"><table><tbody>
+		<tr>
+			<td class="lines-num"><a href="/git/org/lib/src/commit/def456/docs/READ%20ME.md#L1"><span>1</span></a></td>
+			<td class="lines-code chroma"><code class="code-inner"># mainly fuzzy
+</code></td>
+		</tr>
+		<tr>
+			<td class="lines-num"><a href="/git/org/lib/src/commit/def456/docs/READ%20ME.md#L2"><span>2</span></a></td>
+			<td class="lines-code chroma"><code class="code-inner">more
+</code></td>
+		</tr>
+	</tbody></table></div>
+</div>
+</div></body></html>`
+
+func TestParseCodeSearchResults(t *testing.T) {
+	hits, err := parseCodeSearchResults(strings.NewReader(codeSearchPage), "https://example.com/git/")
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.CodeSearchHit{
+		{
+			Repo:       "org/app",
+			Path:       "cmd/main.go",
+			Line:       4,
+			Content:    "func main() {",
+			Highlights: [][2]int{{5, 9}},
+			URL:        "https://example.com/git/org/app/src/commit/abc123/cmd/main.go#L4",
+		},
+		{
+			Repo:    "org/lib",
+			Path:    "docs/READ ME.md",
+			Line:    1,
+			Content: "# mainly fuzzy",
+			URL:     "https://example.com/git/org/lib/src/commit/def456/docs/READ%20ME.md#L1",
+		},
+	}, hits)
+}
+
+func TestCodeSearchURL(t *testing.T) {
+	login := &config.Login{URL: "https://example.com/"}
+	assert.Equal(t, "https://example.com/org/app/search?q=func+main",
+		CodeSearchURL(login, SearchCodeOption{Query: "func main", Owner: "org", Repo: "app"}))
+	assert.Equal(t, "https://example.com/org/-/code?page=2&q=TODO",
+		CodeSearchURL(login, SearchCodeOption{Query: "TODO", Owner: "org", Page: 2}))
+	assert.Equal(t, "https://example.com/explore/code?q=TODO",
+		CodeSearchURL(login, SearchCodeOption{Query: "TODO"}))
+}
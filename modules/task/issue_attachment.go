@@ -0,0 +1,92 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+)
+
+// AttachmentTarget is the issue, pull or comment attachments belong to
+type AttachmentTarget struct {
+	Owner string
+	Repo  string
+	// Index of the issue, used if CommentID is 0
+	Index     int64
+	CommentID int64
+}
+
+func (t AttachmentTarget) path() string {
+	repo := fmt.Sprintf("/repos/%s/%s", url.PathEscape(t.Owner), url.PathEscape(t.Repo))
+	if t.CommentID != 0 {
+		return fmt.Sprintf("%s/issues/comments/%d/assets", repo, t.CommentID)
+	}
+	return fmt.Sprintf("%s/issues/%d/assets", repo, t.Index)
+}
+
+// String describes t for messages
+func (t AttachmentTarget) String() string {
+	if t.CommentID != 0 {
+		return fmt.Sprintf("comment %d", t.CommentID)
+	}
+	return fmt.Sprintf("#%d", t.Index)
+}
+
+// ListAttachments returns the attachments of an issue, pull or comment
+func ListAttachments(login *config.Login, t AttachmentTarget) ([]*gitea.Attachment, error) {
+	var attachments []*gitea.Attachment
+	if _, err := login.APIRequest(http.MethodGet, t.path(), nil, &attachments); err != nil {
+		return nil, fmt.Errorf("could not list attachments of %s: %w", t, err)
+	}
+	return attachments, nil
+}
+
+// UploadAttachment uploads file as attachment to an issue, pull or comment
+func UploadAttachment(login *config.Login, t AttachmentTarget, file string) (*gitea.Attachment, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	name := filepath.Base(file)
+	attachment := &gitea.Attachment{}
+	path := t.path() + "?name=" + url.QueryEscape(name)
+	if err = login.APIUpload(path, "attachment", name, f, attachment); err != nil {
+		return nil, fmt.Errorf("could not attach %s to %s: %w", name, t, err)
+	}
+	return attachment, nil
+}
+
+// DownloadAttachment saves an attachment in dir, and returns the file path.
+// Existing files are not overwritten.
+func DownloadAttachment(login *config.Login, a *gitea.Attachment, dir string) (string, error) {
+	path := filepath.Join(dir, filepath.Base(a.Name))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	if err = login.Download(a.DownloadURL, f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// AttachmentMarkdown returns a markdown link to a, which embeds images
+func AttachmentMarkdown(a *gitea.Attachment) string {
+	switch strings.ToLower(filepath.Ext(a.Name)) {
+	case ".png", ".jpg", ".jpeg", ".gif", ".webp", ".svg":
+		return fmt.Sprintf("![%s](%s)", a.Name, a.DownloadURL)
+	}
+	return fmt.Sprintf("[%s](%s)", a.Name, a.DownloadURL)
+}
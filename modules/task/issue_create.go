@@ -13,20 +13,27 @@ import (
 
 // CreateIssue creates an issue in the given repo and prints the result
 func CreateIssue(login *config.Login, repoOwner, repoName string, opts gitea.CreateIssueOption) error {
-
-	// title is required
-	if len(opts.Title) == 0 {
-		return fmt.Errorf("Title is required")
-	}
-
-	issue, _, err := login.Client().CreateIssue(repoOwner, repoName, opts)
+	issue, err := createIssue(login, repoOwner, repoName, opts)
 	if err != nil {
-		return fmt.Errorf("could not create issue: %s", err)
+		return err
 	}
 
-	print.IssueDetails(issue, nil)
+	print.IssueDetails(issue, nil, nil)
 
 	fmt.Println(issue.HTMLURL)
 
 	return nil
 }
+
+func createIssue(login *config.Login, repoOwner, repoName string, opts gitea.CreateIssueOption) (*gitea.Issue, error) {
+	// title is required
+	if len(opts.Title) == 0 {
+		return nil, fmt.Errorf("Title is required")
+	}
+
+	issue, resp, err := login.Client().CreateIssue(repoOwner, repoName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create issue: %w", config.ClassifyAPIError(resp, err))
+	}
+	return issue, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+)
+
+// DependencyRelation is the relation of an issue to one of its dependencies
+type DependencyRelation string
+
+const (
+	// DependencyBlocks means the issue blocks the dependency
+	DependencyBlocks DependencyRelation = "blocks"
+	// DependencyBlockedBy means the issue is blocked by the dependency
+	DependencyBlockedBy DependencyRelation = "blocked by"
+)
+
+// endpoint returns the API path segment for relation r
+func (r DependencyRelation) endpoint() string {
+	if r == DependencyBlocks {
+		return "blocks"
+	}
+	return "dependencies"
+}
+
+// issueMeta references an issue in API requests for dependencies
+type issueMeta struct {
+	Index int64  `json:"index"`
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+}
+
+func issueDepsPath(owner, repo string, index int64, relation DependencyRelation) string {
+	return fmt.Sprintf("/repos/%s/%s/issues/%d/%s", url.PathEscape(owner), url.PathEscape(repo), index, relation.endpoint())
+}
+
+// ListIssueDependencies returns the issues blocking the given issue, and the
+// issues blocked by it.
+func ListIssueDependencies(login *config.Login, owner, repo string, index int64) (blockedBy, blocks []*gitea.Issue, err error) {
+	if blockedBy, err = listIssueDependencies(login, owner, repo, index, DependencyBlockedBy); err != nil {
+		return nil, nil, err
+	}
+	if blocks, err = listIssueDependencies(login, owner, repo, index, DependencyBlocks); err != nil {
+		return nil, nil, err
+	}
+	return blockedBy, blocks, nil
+}
+
+func listIssueDependencies(login *config.Login, owner, repo string, index int64, relation DependencyRelation) ([]*gitea.Issue, error) {
+	issues, err := ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Issue, *gitea.Response, error) {
+		var batch []*gitea.Issue
+		path := fmt.Sprintf("%s?page=%d&limit=%d", issueDepsPath(owner, repo, index, relation), opt.Page, opt.PageSize)
+		resp, err := login.APIRequest(http.MethodGet, path, nil, &batch)
+		return batch, resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list dependencies of #%d: %w", index, err)
+	}
+	return issues, nil
+}
+
+// AddIssueDependency records that the given issue blocks or is blocked by dep.
+// dep may reference an issue in another repo, and defaults to owner/repo otherwise.
+func AddIssueDependency(login *config.Login, owner, repo string, index int64, relation DependencyRelation, dep utils.IndexRef) error {
+	return editIssueDependency(http.MethodPost, login, owner, repo, index, relation, dep)
+}
+
+// RemoveIssueDependency removes a dependency added with AddIssueDependency.
+func RemoveIssueDependency(login *config.Login, owner, repo string, index int64, relation DependencyRelation, dep utils.IndexRef) error {
+	return editIssueDependency(http.MethodDelete, login, owner, repo, index, relation, dep)
+}
+
+func editIssueDependency(method string, login *config.Login, owner, repo string, index int64, relation DependencyRelation, dep utils.IndexRef) error {
+	meta := issueMeta{Index: dep.Index, Owner: owner, Repo: repo}
+	if len(dep.Owner) != 0 {
+		meta.Owner, meta.Repo = dep.Owner, dep.Repo
+	}
+	_, err := login.APIRequest(method, issueDepsPath(owner, repo, index, relation), meta, nil)
+	return err
+}
+
+// IssueDependencyGraph returns all issues & pulls of a milestone together with
+// the issues they block, and maps the ref of each issue to the refs of the
+// issues it blocks. Refs are formatted with print.IssueRef.
+func IssueDependencyGraph(login *config.Login, owner, repo, milestone string) ([]*gitea.Issue, map[string][]string, error) {
+	client := login.Client()
+	issues, err := ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Issue, *gitea.Response, error) {
+		return client.ListRepoIssues(owner, repo, gitea.ListIssueOption{
+			ListOptions: opt,
+			State:       gitea.StateAll,
+			Type:        gitea.IssueTypeAll,
+			Milestones:  []string{milestone},
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[print.IssueRef(issue, "")] = true
+	}
+
+	// blocked issues outside of the milestone are included in the graph and
+	// expanded as well, until no new issues are found
+	blocks := make(map[string][]string)
+	pending := issues
+	for len(pending) != 0 {
+		blocked, err := listBlockedIssues(login, owner, repo, pending)
+		if err != nil {
+			return nil, nil, err
+		}
+		var next []*gitea.Issue
+		for i, issue := range pending {
+			ref := print.IssueRef(issue, "")
+			for _, b := range blocked[i] {
+				bRef := print.IssueRef(b, "")
+				blocks[ref] = append(blocks[ref], bRef)
+				if !known[bRef] {
+					known[bRef] = true
+					issues = append(issues, b)
+					next = append(next, b)
+				}
+			}
+		}
+		pending = next
+	}
+	return issues, blocks, nil
+}
+
+const listBlockedIssuesConcurrency = 4
+
+// listBlockedIssues concurrently lists the issues blocked by each of issues,
+// which default to owner/repo unless they reference their repository.
+func listBlockedIssues(login *config.Login, owner, repo string, issues []*gitea.Issue) ([][]*gitea.Issue, error) {
+	blocked := make([][]*gitea.Issue, len(issues))
+	errs := make([]error, len(issues))
+	sem := make(chan struct{}, listBlockedIssuesConcurrency)
+	var wg sync.WaitGroup
+	for i, issue := range issues {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, issue *gitea.Issue) {
+			defer wg.Done()
+			o, r := owner, repo
+			if issue.Repository != nil {
+				if parts := strings.SplitN(issue.Repository.FullName, "/", 2); len(parts) == 2 {
+					o, r = parts[0], parts[1]
+				}
+			}
+			blocked[i], errs[i] = listIssueDependencies(login, o, r, issue.Index, DependencyBlocks)
+			<-sem
+		}(i, issue)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blocked, nil
+}
@@ -5,10 +5,14 @@ package task
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
 )
 
 // EditIssueOption wraps around gitea.EditIssueOption which has bad & incosistent semantics.
@@ -22,7 +26,41 @@ type EditIssueOption struct {
 	AddLabels    []string
 	RemoveLabels []string
 	AddAssignees []string
-	// RemoveAssignees []string // NOTE: with the current go-sdk, clearing assignees is not possible.
+	// RemoveAssignees and AddAssignees are applied to the current assignees of each issue.
+	RemoveAssignees []string
+	// SetAssignees replaces all assignees, an empty non-nil slice unassigns everyone.
+	SetAssignees []string
+}
+
+// assigneeChange holds relative changes to the assignees of an issue.
+// The API only accepts the complete list, so it is applied to the current assignees.
+type assigneeChange struct {
+	add, remove []string
+}
+
+// apply returns the assignees resulting from applying c to current.
+func (c *assigneeChange) apply(current []*gitea.User) []string {
+	contains := func(list []string, user string) bool {
+		for _, u := range list {
+			if strings.EqualFold(u, user) {
+				return true
+			}
+		}
+		return false
+	}
+
+	assignees := make([]string, 0, len(current)+len(c.add))
+	for _, u := range current {
+		if !contains(c.remove, u.UserName) {
+			assignees = append(assignees, u.UserName)
+		}
+	}
+	for _, u := range c.add {
+		if !contains(assignees, u) && !contains(c.remove, u) {
+			assignees = append(assignees, u)
+		}
+	}
+	return assignees
 }
 
 // Normalizes the options into parameters that can be passed to the sdk.
@@ -64,9 +102,9 @@ func (o EditIssueOption) toSdkOptions(ctx *context.TeaContext, client *gitea.Cli
 		if *o.Milestone == "" {
 			issueOpts.Milestone = gitea.OptionalInt64(0)
 		} else {
-			ms, _, err := client.GetMilestoneByName(ctx.Owner, ctx.Repo, *o.Milestone)
+			ms, err := GetMilestoneByName(client, ctx.Owner, ctx.Repo, *o.Milestone)
 			if err != nil {
-				return nil, nil, nil, fmt.Errorf("Milestone '%s' not found", *o.Milestone)
+				return nil, nil, nil, err
 			}
 			issueOpts.Milestone = &ms.ID
 		}
@@ -79,8 +117,8 @@ func (o EditIssueOption) toSdkOptions(ctx *context.TeaContext, client *gitea.Cli
 			issueOpts.RemoveDeadline = gitea.OptionalBool(true)
 		}
 	}
-	if o.AddAssignees != nil && len(o.AddAssignees) != 0 {
-		issueOpts.Assignees = o.AddAssignees
+	if o.SetAssignees != nil {
+		issueOpts.Assignees = o.SetAssignees
 		issueOptsDirty = true
 	}
 
@@ -90,6 +128,14 @@ func (o EditIssueOption) toSdkOptions(ctx *context.TeaContext, client *gitea.Cli
 	return nil, addLabelOpts, rmLabelOpts, nil
 }
 
+// assigneeChange returns the relative assignee changes of o, or nil if there are none.
+func (o EditIssueOption) assigneeChange() *assigneeChange {
+	if len(o.AddAssignees) == 0 && len(o.RemoveAssignees) == 0 {
+		return nil
+	}
+	return &assigneeChange{add: o.AddAssignees, remove: o.RemoveAssignees}
+}
+
 // EditIssue edits an issue and returns the updated issue.
 func EditIssue(ctx *context.TeaContext, client *gitea.Client, opts EditIssueOption) (*gitea.Issue, error) {
 	if client == nil {
@@ -100,34 +146,105 @@ func EditIssue(ctx *context.TeaContext, client *gitea.Client, opts EditIssueOpti
 	if err != nil {
 		return nil, err
 	}
+	return editIssue(ctx, client, opts.Index, issueOpts, opts.assigneeChange(), addLabelOpts, rmLabelOpts)
+}
+
+// EditIssueResult is the outcome of editing a single issue with EditIssues
+type EditIssueResult struct {
+	Index int64
+	Issue *gitea.Issue
+	Err   error
+}
 
+// editIssuesConcurrency limits the number of parallel requests of EditIssues
+const editIssuesConcurrency = 4
+
+// EditIssues applies opts to the issues with the given indices concurrently.
+// progress is called whenever an edit completed. The results are ordered
+// like indices.
+func EditIssues(ctx *context.TeaContext, client *gitea.Client, opts EditIssueOption, indices []int64, progress func(done, total int)) ([]EditIssueResult, error) {
+	if client == nil {
+		client = ctx.Login.Client()
+	}
+
+	// resolve label & milestone names once for all issues
+	issueOpts, addLabelOpts, rmLabelOpts, err := opts.toSdkOptions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]EditIssueResult, len(indices))
+	sem := make(chan struct{}, editIssuesConcurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	done := 0
+	for i, index := range indices {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, index int64) {
+			defer wg.Done()
+			issue, err := editIssue(ctx, client, index, issueOpts, opts.assigneeChange(), addLabelOpts, rmLabelOpts)
+			results[i] = EditIssueResult{Index: index, Issue: issue, Err: err}
+			<-sem
+
+			mu.Lock()
+			done++
+			if progress != nil {
+				progress(done, len(indices))
+			}
+			mu.Unlock()
+		}(i, index)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+func editIssue(ctx *context.TeaContext, client *gitea.Client, index int64, issueOpts *gitea.EditIssueOption, assignees *assigneeChange, addLabelOpts, rmLabelOpts *gitea.IssueLabelsOption) (*gitea.Issue, error) {
+	var err error
+	if assignees != nil {
+		current, resp, err := client.GetIssue(ctx.Owner, ctx.Repo, index)
+		if err != nil {
+			return nil, fmt.Errorf("could not get issue: %w", config.ClassifyAPIError(resp, err))
+		}
+		// copy, as issueOpts is shared between concurrent edits
+		opts := gitea.EditIssueOption{}
+		if issueOpts != nil {
+			opts = *issueOpts
+		}
+		opts.Assignees = assignees.apply(current.Assignees)
+		issueOpts = &opts
+	}
 	if rmLabelOpts != nil {
 		// NOTE: as of 1.17, there is no API to remove multiple labels at once.
 		for _, id := range rmLabelOpts.Labels {
-			_, err := client.DeleteIssueLabel(ctx.Owner, ctx.Repo, opts.Index, id)
-			if err != nil {
-				return nil, fmt.Errorf("could not remove labels: %s", err)
+			resp, err := client.DeleteIssueLabel(ctx.Owner, ctx.Repo, index, id)
+			if err != nil && !utils.IsDryRun(err) {
+				return nil, fmt.Errorf("could not remove labels: %w", config.ClassifyAPIError(resp, err))
 			}
 		}
 	}
 
 	if addLabelOpts != nil {
-		_, _, err := client.AddIssueLabels(ctx.Owner, ctx.Repo, opts.Index, *addLabelOpts)
-		if err != nil {
-			return nil, fmt.Errorf("could not add labels: %s", err)
+		_, resp, err := client.AddIssueLabels(ctx.Owner, ctx.Repo, index, *addLabelOpts)
+		if err != nil && !utils.IsDryRun(err) {
+			return nil, fmt.Errorf("could not add labels: %w", config.ClassifyAPIError(resp, err))
 		}
 	}
 
 	var issue *gitea.Issue
 	if issueOpts != nil {
-		issue, _, err = client.EditIssue(ctx.Owner, ctx.Repo, opts.Index, *issueOpts)
+		var resp *gitea.Response
+		issue, resp, err = client.EditIssue(ctx.Owner, ctx.Repo, index, *issueOpts)
 		if err != nil {
-			return nil, fmt.Errorf("could not edit issue: %s", err)
+			return nil, fmt.Errorf("could not edit issue: %w", config.ClassifyAPIError(resp, err))
 		}
+	} else if utils.DryRun() {
+		return nil, utils.ErrDryRun
 	} else {
-		issue, _, err = client.GetIssue(ctx.Owner, ctx.Repo, opts.Index)
+		var resp *gitea.Response
+		issue, resp, err = client.GetIssue(ctx.Owner, ctx.Repo, index)
 		if err != nil {
-			return nil, fmt.Errorf("could not get issue: %s", err)
+			return nil, fmt.Errorf("could not get issue: %w", config.ClassifyAPIError(resp, err))
 		}
 	}
 	return issue, nil
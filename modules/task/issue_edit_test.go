@@ -0,0 +1,24 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssigneeChange(t *testing.T) {
+	current := []*gitea.User{{UserName: "alice"}, {UserName: "bob"}}
+
+	c := &assigneeChange{add: []string{"me", "Bob"}, remove: []string{"alice"}}
+	assert.EqualValues(t, []string{"bob", "me"}, c.apply(current))
+
+	c = &assigneeChange{remove: []string{"alice", "bob"}}
+	assert.EqualValues(t, []string{}, c.apply(current))
+
+	c = &assigneeChange{add: []string{"carol"}}
+	assert.EqualValues(t, []string{"carol"}, c.apply(nil))
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+
+	"github.com/araddon/dateparse"
+	"gopkg.in/yaml.v3"
+)
+
+// IssueDraft holds the properties of an issue or pull request to create,
+// as read from the front matter and body of a markdown file.
+type IssueDraft struct {
+	Title     string     `yaml:"title"`
+	Labels    stringList `yaml:"labels"`
+	Milestone string     `yaml:"milestone"`
+	Assignees stringList `yaml:"assignees"`
+	Deadline  string     `yaml:"deadline"`
+	Ref       string     `yaml:"ref"`
+	Base      string     `yaml:"base"`
+	Head      string     `yaml:"head"`
+	Body      string     `yaml:"-"`
+}
+
+// ReadIssueDrafts reads drafts from the given markdown file, or from stdin if
+// the file name is "-". See ParseIssueDrafts for the format.
+func ReadIssueDrafts(file string, stdin io.Reader) ([]*IssueDraft, error) {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueDrafts(data)
+}
+
+// ParseIssueDrafts parses a markdown file with YAML front matter. A file may
+// contain several drafts, each starting with its own front matter block.
+// A "---" line in a body starts a new draft only if it is followed by a
+// front matter block that sets a title.
+func ParseIssueDrafts(data []byte) ([]*IssueDraft, error) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	i := 0
+	for i < len(lines) && len(strings.TrimSpace(lines[i])) == 0 {
+		i++
+	}
+	if i == len(lines) {
+		return nil, utils.NewInvalidArgumentErrorf("file is empty")
+	}
+
+	if lines[i] != "---" {
+		// no front matter at all, the file is just the body
+		return []*IssueDraft{{Body: strings.TrimSpace(strings.Join(lines[i:], "\n"))}}, nil
+	}
+
+	var drafts []*IssueDraft
+	for i < len(lines) {
+		end := frontMatterEnd(lines, i)
+		if end < 0 {
+			return nil, utils.NewInvalidArgumentErrorf("front matter starting at line %d is not terminated", i+1)
+		}
+		d := &IssueDraft{}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[i+1:end], "\n")), d); err != nil {
+			return nil, utils.NewInvalidArgumentErrorf("invalid front matter starting at line %d: %v", i+1, err)
+		}
+
+		next := len(lines)
+		for j := end + 1; j < len(lines); j++ {
+			if lines[j] == "---" && isDraftFrontMatter(lines, j) {
+				next = j
+				break
+			}
+		}
+		d.Body = strings.TrimSpace(strings.Join(lines[end+1:next], "\n"))
+		drafts = append(drafts, d)
+		i = next
+	}
+	return drafts, nil
+}
+
+// frontMatterEnd returns the index of the line closing the front matter that
+// starts at line start, or -1.
+func frontMatterEnd(lines []string, start int) int {
+	for j := start + 1; j < len(lines); j++ {
+		if lines[j] == "---" {
+			return j
+		}
+	}
+	return -1
+}
+
+// isDraftFrontMatter checks if the "---" at line start opens a front matter
+// block, rather than being a horizontal rule in a body.
+func isDraftFrontMatter(lines []string, start int) bool {
+	end := frontMatterEnd(lines, start)
+	if end < 0 {
+		return false
+	}
+	var fm map[string]interface{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[start+1:end], "\n")), &fm); err != nil {
+		return false
+	}
+	_, ok := fm["title"]
+	return ok
+}
+
+// createOptions converts d to options for creating an issue, with values
+// from defaults for properties that d doesn't set.
+func (d *IssueDraft) createOptions(ctx *context.TeaContext, defaults gitea.CreateIssueOption) (*gitea.CreateIssueOption, error) {
+	opts := defaults
+	client := ctx.Login.Client()
+
+	if len(d.Title) != 0 {
+		opts.Title = d.Title
+	}
+	if len(d.Body) != 0 {
+		opts.Body = d.Body
+	}
+	if len(d.Ref) != 0 {
+		opts.Ref = d.Ref
+	}
+	if len(d.Assignees) != 0 {
+		opts.Assignees = d.Assignees
+	}
+	if len(d.Labels) != 0 {
+		labelIDs, err := ResolveLabelNames(client, ctx.Owner, ctx.Repo, d.Labels)
+		if err != nil {
+			return nil, err
+		}
+		opts.Labels = labelIDs
+	}
+	if len(d.Milestone) != 0 {
+		ms, err := GetMilestoneByName(client, ctx.Owner, ctx.Repo, d.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		opts.Milestone = ms.ID
+	}
+	if len(d.Deadline) != 0 {
+		t, err := dateparse.ParseAny(d.Deadline)
+		if err != nil {
+			return nil, utils.NewInvalidArgumentErrorf("invalid deadline '%s' of '%s': %v", d.Deadline, d.Title, err)
+		}
+		opts.Deadline = &t
+	}
+	return &opts, nil
+}
+
+// CreateIssuesFromDrafts creates an issue for each draft in the repo of ctx,
+// and prints a summary of the created issues. defaults provides
+// the properties that drafts don't set.
+func CreateIssuesFromDrafts(ctx *context.TeaContext, drafts []*IssueDraft, defaults gitea.CreateIssueOption) error {
+	var created []*gitea.Issue
+	defer func() {
+		if len(created) != 0 {
+			print.IssuesPullsList(created, ctx.Output, []string{"index", "title", "labels", "url"})
+		}
+	}()
+
+	for _, d := range drafts {
+		opts, err := d.createOptions(ctx, defaults)
+		if err != nil {
+			return err
+		}
+		issue, err := createIssue(ctx.Login, ctx.Owner, ctx.Repo, *opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		created = append(created, issue)
+	}
+	return nil
+}
+
+// CreatePullsFromDrafts creates a pull request for each draft in the repo of
+// ctx, and prints a summary of the created pulls. base, head and
+// defaults provide the properties that drafts don't set.
+func CreatePullsFromDrafts(ctx *context.TeaContext, drafts []*IssueDraft, defaults gitea.CreateIssueOption, base, head string, allowMaintainerEdits bool) error {
+	var created []*gitea.PullRequest
+	defer func() {
+		if len(created) != 0 {
+			print.PullsList(created, ctx.Output, []string{"index", "title", "head", "base", "url"})
+		}
+	}()
+
+	for _, d := range drafts {
+		opts, err := d.createOptions(ctx, defaults)
+		if err != nil {
+			return err
+		}
+		draftBase, draftHead := base, head
+		if len(d.Base) != 0 {
+			draftBase = d.Base
+		}
+		if len(d.Head) != 0 {
+			draftHead = d.Head
+		}
+		pr, err := createPull(ctx, draftBase, draftHead, allowMaintainerEdits, opts)
+		if utils.IsDryRun(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		created = append(created, pr)
+	}
+	return nil
+}
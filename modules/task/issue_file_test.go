@@ -0,0 +1,48 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIssueDrafts(t *testing.T) {
+	drafts, err := ParseIssueDrafts([]byte(`
+---
+title: First
+labels: bug, ui
+deadline: 2026-12-31
+---
+Body one
+
+---
+
+still body one
+---
+title: Second
+base: main
+head: feature
+---
+Body two
+`))
+	assert.NoError(t, err)
+	assert.Len(t, drafts, 2)
+	assert.Equal(t, "First", drafts[0].Title)
+	assert.EqualValues(t, []string{"bug", "ui"}, drafts[0].Labels)
+	assert.Equal(t, "2026-12-31", drafts[0].Deadline)
+	assert.Equal(t, "Body one\n\n---\n\nstill body one", drafts[0].Body)
+	assert.Equal(t, "main", drafts[1].Base)
+	assert.Equal(t, "feature", drafts[1].Head)
+	assert.Equal(t, "Body two", drafts[1].Body)
+
+	drafts, err = ParseIssueDrafts([]byte("no front matter\n"))
+	assert.NoError(t, err)
+	assert.Len(t, drafts, 1)
+	assert.Equal(t, "no front matter", drafts[0].Body)
+
+	_, err = ParseIssueDrafts([]byte("---\ntitle: unterminated\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+)
+
+// SearchIssuesOption are the filters of SearchIssues
+type SearchIssuesOption struct {
+	State      gitea.StateType
+	Type       gitea.IssueType
+	Labels     []string
+	Milestones []string
+	KeyWord    string
+	Since      time.Time
+	Before     time.Time
+	// Owner restricts the search to repos of a user or organization
+	Owner string
+	// Team restricts the search to repos of a team of organization Owner
+	Team string
+	// Author and Assignee filter by username. The API only supports
+	// filtering for the login user, others are filtered client side while
+	// paging through all results, so Owner should be set for them.
+	Author   string
+	Assignee string
+	// Mentioned, ReviewRequested and Reviewed filter for the login user
+	Mentioned       bool
+	ReviewRequested bool
+	Reviewed        bool
+	// Limit is the maximum number of results, 0 means all
+	Limit int
+}
+
+// SearchIssues searches issues & pulls of all repositories accessible to the
+// login user, fetching as many pages as needed.
+func SearchIssues(login *config.Login, opt SearchIssuesOption) ([]*gitea.Issue, error) {
+	query := url.Values{}
+	if opt.State != "" {
+		query.Set("state", string(opt.State))
+	}
+	if opt.Type != gitea.IssueTypeAll {
+		query.Set("type", string(opt.Type))
+	}
+	if len(opt.Labels) != 0 {
+		query.Set("labels", strings.Join(opt.Labels, ","))
+	}
+	if len(opt.Milestones) != 0 {
+		query.Set("milestones", strings.Join(opt.Milestones, ","))
+	}
+	if opt.KeyWord != "" {
+		query.Set("q", opt.KeyWord)
+	}
+	if !opt.Since.IsZero() {
+		query.Set("since", opt.Since.Format(time.RFC3339))
+	}
+	if !opt.Before.IsZero() {
+		query.Set("before", opt.Before.Format(time.RFC3339))
+	}
+	if opt.Owner != "" {
+		query.Set("owner", opt.Owner)
+	}
+	if opt.Team != "" {
+		query.Set("team", opt.Team)
+	}
+	isLoginUser := func(name string) bool { return strings.EqualFold(name, login.User) }
+	for param, set := range map[string]bool{
+		"created":          isLoginUser(opt.Author),
+		"assigned":         isLoginUser(opt.Assignee),
+		"mentioned":        opt.Mentioned,
+		"review_requested": opt.ReviewRequested,
+		"reviewed":         opt.Reviewed,
+	} {
+		if set {
+			query.Set(param, "true")
+		}
+	}
+
+	var results []*gitea.Issue
+	err := ForEachPage(func(page gitea.ListOptions) ([]*gitea.Issue, *gitea.Response, error) {
+		query.Set("page", fmt.Sprint(page.Page))
+		query.Set("limit", fmt.Sprint(page.PageSize))
+		var batch []*gitea.Issue
+		resp, err := login.APIRequest(http.MethodGet, "/repos/issues/search?"+query.Encode(), nil, &batch)
+		return batch, resp, err
+	}, func(batch []*gitea.Issue) bool {
+		for _, issue := range batch {
+			if matchesSearchUsers(issue, opt) {
+				results = append(results, issue)
+			}
+			if opt.Limit > 0 && len(results) == opt.Limit {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// matchesSearchUsers filters issue by the author and assignee of opt, as the
+// API only filters by the login user.
+func matchesSearchUsers(issue *gitea.Issue, opt SearchIssuesOption) bool {
+	if opt.Author != "" && (issue.Poster == nil || !strings.EqualFold(issue.Poster.UserName, opt.Author)) {
+		return false
+	}
+	if opt.Assignee != "" {
+		for _, a := range issue.Assignees {
+			if strings.EqualFold(a.UserName, opt.Assignee) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
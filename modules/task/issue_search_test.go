@@ -0,0 +1,24 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesSearchUsers(t *testing.T) {
+	issue := &gitea.Issue{
+		Poster:    &gitea.User{UserName: "alice"},
+		Assignees: []*gitea.User{{UserName: "bob"}, {UserName: "carol"}},
+	}
+
+	assert.True(t, matchesSearchUsers(issue, SearchIssuesOption{}))
+	assert.True(t, matchesSearchUsers(issue, SearchIssuesOption{Author: "Alice", Assignee: "carol"}))
+	assert.False(t, matchesSearchUsers(issue, SearchIssuesOption{Author: "bob"}))
+	assert.False(t, matchesSearchUsers(issue, SearchIssuesOption{Assignee: "alice"}))
+	assert.False(t, matchesSearchUsers(&gitea.Issue{}, SearchIssuesOption{Author: "alice"}))
+}
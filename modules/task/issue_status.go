@@ -0,0 +1,170 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+)
+
+func issuePath(owner, repo string, index int64, suffix string) string {
+	return fmt.Sprintf("/repos/%s/%s/issues/%d%s", url.PathEscape(owner), url.PathEscape(repo), index, suffix)
+}
+
+// PinIssue pins or unpins an issue or pull request
+func PinIssue(login *config.Login, owner, repo string, index int64, pin bool) error {
+	method := http.MethodPost
+	if !pin {
+		method = http.MethodDelete
+	}
+	_, err := login.APIRequest(method, issuePath(owner, repo, index, "/pin"), nil, nil)
+	return err
+}
+
+// LockIssue locks the conversation of an issue or pull request, so only
+// collaborators can comment. reason may be empty.
+func LockIssue(login *config.Login, owner, repo string, index int64, reason string) error {
+	body := struct {
+		Reason string `json:"lock_reason"`
+	}{reason}
+	_, err := login.APIRequest(http.MethodPut, issuePath(owner, repo, index, "/lock"), body, nil)
+	return err
+}
+
+// UnlockIssue unlocks the conversation of an issue or pull request
+func UnlockIssue(login *config.Login, owner, repo string, index int64) error {
+	_, err := login.APIRequest(http.MethodDelete, issuePath(owner, repo, index, "/lock"), nil, nil)
+	return err
+}
+
+// SubscribeIssue subscribes the login user to notifications of an issue or
+// pull request, or unsubscribes them.
+func SubscribeIssue(login *config.Login, owner, repo string, index int64, subscribe bool) error {
+	var resp *gitea.Response
+	var err error
+	if subscribe {
+		resp, err = login.Client().IssueSubscribe(owner, repo, index)
+	} else {
+		resp, err = login.Client().IssueUnSubscribe(owner, repo, index)
+	}
+	return config.ClassifyAPIError(resp, err)
+}
+
+// GetIssueWithExtras fetches an issue, and its details that are not part of
+// gitea.Issue. Dependencies are left empty if they are disabled for the repo.
+func GetIssueWithExtras(login *config.Login, owner, repo string, index int64) (*gitea.Issue, *print.IssueExtras, error) {
+	// the pin order & attachments are returned with the issue, but missing in gitea.Issue
+	var raw struct {
+		gitea.Issue
+		PinOrder int                 `json:"pin_order"`
+		Assets   []*gitea.Attachment `json:"assets"`
+	}
+	if _, err := login.APIRequest(http.MethodGet, issuePath(owner, repo, index, ""), nil, &raw); err != nil {
+		return nil, nil, err
+	}
+	extras := &print.IssueExtras{Pinned: raw.PinOrder > 0, Attachments: raw.Assets}
+
+	// the issue is still worth printing without its subscription state
+	watch, resp, err := login.Client().CheckIssueSubscription(owner, repo, index)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: could not check subscription of #%d: %s\n", index, config.ClassifyAPIError(resp, err))
+	} else {
+		extras.Subscribed = watch.Subscribed
+	}
+
+	extras.BlockedBy, extras.Blocks, err = ListIssueDependencies(login, owner, repo, index)
+	if err != nil && !errors.Is(err, utils.ErrNotExist) {
+		return nil, nil, err
+	}
+	return &raw.Issue, extras, nil
+}
+
+// listIssueExtrasConcurrency limits the number of parallel requests of ListIssueExtras
+const listIssueExtrasConcurrency = 4
+
+// ListIssueExtras fetches the IssueExtras required to print fields for issues,
+// which may belong to different repos. The result maps issue IDs to extras.
+func ListIssueExtras(login *config.Login, issues []*gitea.Issue, fields []string) (map[int64]*print.IssueExtras, error) {
+	pinned, subscribed := utils.Contains(fields, "pinned"), utils.Contains(fields, "subscribed")
+	extras := make(map[int64]*print.IssueExtras, len(issues))
+	if !pinned && !subscribed {
+		return extras, nil
+	}
+
+	pinnedByRepo := map[string]map[int64]bool{}
+	for _, issue := range issues {
+		if issue.Repository == nil {
+			return nil, fmt.Errorf("repository of issue #%d unknown", issue.Index)
+		}
+		e := &print.IssueExtras{}
+		if pinned {
+			if _, ok := pinnedByRepo[issue.Repository.FullName]; !ok {
+				indices, err := listPinnedIssues(login, issue.Repository.Owner, issue.Repository.Name)
+				if err != nil {
+					return nil, err
+				}
+				pinnedByRepo[issue.Repository.FullName] = indices
+			}
+			e.Pinned = pinnedByRepo[issue.Repository.FullName][issue.Index]
+		}
+		extras[issue.ID] = e
+	}
+	if !subscribed {
+		return extras, nil
+	}
+
+	// there is no API listing the subscriptions of a user, so each issue is checked
+	client := login.Client()
+	errs := make([]error, len(issues))
+	sem := make(chan struct{}, listIssueExtrasConcurrency)
+	var wg sync.WaitGroup
+	for i, issue := range issues {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, issue *gitea.Issue) {
+			defer wg.Done()
+			watch, resp, err := client.CheckIssueSubscription(issue.Repository.Owner, issue.Repository.Name, issue.Index)
+			if err != nil {
+				errs[i] = config.ClassifyAPIError(resp, err)
+			} else {
+				extras[issue.ID].Subscribed = watch.Subscribed
+			}
+			<-sem
+		}(i, issue)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return extras, nil
+}
+
+// listPinnedIssues returns the indices of the pinned issues & pulls of a repo
+func listPinnedIssues(login *config.Login, owner, repo string) (map[int64]bool, error) {
+	indices := map[int64]bool{}
+	for _, kind := range []string{"issues", "pulls"} {
+		var pinned []struct {
+			Index int64 `json:"number"`
+		}
+		path := fmt.Sprintf("/repos/%s/%s/%s/pinned", url.PathEscape(owner), url.PathEscape(repo), kind)
+		if _, err := login.APIRequest(http.MethodGet, path, nil, &pinned); err != nil {
+			return nil, fmt.Errorf("could not list pinned %s: %w", kind, err)
+		}
+		for _, p := range pinned {
+			indices[p.Index] = true
+		}
+	}
+	return indices, nil
+}
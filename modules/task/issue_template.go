@@ -0,0 +1,413 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/context"
+	"code.gitea.io/tea/modules/utils"
+
+	"gopkg.in/yaml.v3"
+)
+
+// templateBaseDirs are the directories searched for issue & PR templates,
+// matching the locations supported by Gitea.
+var templateBaseDirs = []string{"", ".gitea", ".github", ".gitlab"}
+
+// IssueTemplate is an issue or pull request template. It is either a markdown
+// file with optional front matter, or a YAML issue form.
+type IssueTemplate struct {
+	Path      string     `yaml:"-"`
+	Name      string     `yaml:"name"`
+	About     string     `yaml:"about"`
+	Title     string     `yaml:"title"`
+	Labels    stringList `yaml:"labels"`
+	Assignees stringList `yaml:"assignees"`
+	Ref       string     `yaml:"ref"`
+	// Content is the markdown body of a markdown template
+	Content string `yaml:"-"`
+	// Fields are the fields of an issue form, to be rendered by RenderForm
+	Fields []*IssueFormField `yaml:"body"`
+}
+
+// IsForm reports whether the template is a YAML issue form
+func (t *IssueTemplate) IsForm() bool {
+	return len(t.Fields) != 0
+}
+
+// IssueFormField is a field of an issue form
+type IssueFormField struct {
+	Type        string                    `yaml:"type"` // markdown, input, textarea, dropdown or checkboxes
+	ID          string                    `yaml:"id"`
+	Attributes  IssueFormFieldAttributes  `yaml:"attributes"`
+	Validations IssueFormFieldValidations `yaml:"validations"`
+}
+
+// IssueFormFieldAttributes configure the presentation of an IssueFormField
+type IssueFormFieldAttributes struct {
+	Label       string            `yaml:"label"`
+	Description string            `yaml:"description"`
+	Placeholder string            `yaml:"placeholder"`
+	Value       string            `yaml:"value"`
+	Render      string            `yaml:"render"`
+	Multiple    bool              `yaml:"multiple"`
+	Options     []IssueFormOption `yaml:"options"`
+}
+
+// IssueFormFieldValidations restrict the accepted values of an IssueFormField
+type IssueFormFieldValidations struct {
+	Required bool   `yaml:"required"`
+	IsNumber bool   `yaml:"is_number"`
+	Regex    string `yaml:"regex"`
+}
+
+// IssueFormOption is an option of a dropdown or checkboxes field
+type IssueFormOption struct {
+	Label    string `yaml:"label"`
+	Required bool   `yaml:"required"`
+}
+
+// UnmarshalYAML accepts options given as plain string, as used by dropdowns
+func (o *IssueFormOption) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		o.Label = value.Value
+		return nil
+	}
+	type plain IssueFormOption
+	return value.Decode((*plain)(o))
+}
+
+// Validate checks a value for an input or textarea field
+func (f *IssueFormField) Validate(value string) error {
+	if len(strings.TrimSpace(value)) == 0 {
+		if f.Validations.Required {
+			return fmt.Errorf("'%s' is required", f.Attributes.Label)
+		}
+		return nil
+	}
+	if f.Validations.IsNumber {
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("'%s' must be a number", f.Attributes.Label)
+		}
+	}
+	if len(f.Validations.Regex) != 0 {
+		re, err := regexp.Compile(f.Validations.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex in template field '%s': %w", f.Attributes.Label, err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("'%s' must match %s", f.Attributes.Label, f.Validations.Regex)
+		}
+	}
+	return nil
+}
+
+// stringList is a list of strings, that may be given as comma separated string
+type stringList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = nil
+		for _, s := range strings.Split(value.Value, ",") {
+			if s = strings.TrimSpace(s); len(s) != 0 {
+				*l = append(*l, s)
+			}
+		}
+		return nil
+	}
+	var list []string
+	if err := value.Decode(&list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// RenderForm renders the values given for the fields of a form to a
+// markdown body, the same way the Gitea web UI does. values are indexed like
+// t.Fields, and hold the selected options for dropdowns and checkboxes.
+func (t *IssueTemplate) RenderForm(values [][]string) string {
+	var b strings.Builder
+	for i, f := range t.Fields {
+		if f.Type == "markdown" {
+			continue
+		}
+		var value []string
+		if i < len(values) {
+			value = values[i]
+		}
+
+		fmt.Fprintf(&b, "### %s\n\n", f.Attributes.Label)
+		switch {
+		case f.Type == "checkboxes":
+			for _, o := range f.Attributes.Options {
+				check := " "
+				if utils.Contains(value, o.Label) {
+					check = "x"
+				}
+				fmt.Fprintf(&b, "- [%s] %s\n", check, o.Label)
+			}
+		case len(strings.TrimSpace(strings.Join(value, ""))) == 0:
+			b.WriteString("_No response_\n")
+		case f.Type == "textarea" && len(f.Attributes.Render) != 0:
+			fmt.Fprintf(&b, "```%s\n%s\n```\n", f.Attributes.Render, strings.Join(value, "\n"))
+		default:
+			b.WriteString(strings.Join(value, ", ") + "\n")
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// ParseIssueTemplate parses a markdown or YAML template read from path
+func ParseIssueTemplate(filePath string, data []byte) (*IssueTemplate, error) {
+	t := &IssueTemplate{Path: filePath}
+	ext := strings.ToLower(path.Ext(filePath))
+
+	if ext == ".yaml" || ext == ".yml" {
+		if err := yaml.Unmarshal(data, t); err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", filePath, err)
+		}
+	} else {
+		content := string(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n")))
+		if rest, ok := strings.CutPrefix(content, "---\n"); ok {
+			frontMatter, body, found := cutFrontMatter(rest)
+			if !found {
+				return nil, fmt.Errorf("invalid template %s: front matter is not terminated", filePath)
+			}
+			if err := yaml.Unmarshal([]byte(frontMatter), t); err != nil {
+				return nil, fmt.Errorf("invalid template %s: %w", filePath, err)
+			}
+			content = body
+		}
+		t.Content = content
+		t.Fields = nil
+	}
+
+	if len(t.Name) == 0 {
+		t.Name = strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+	}
+	return t, nil
+}
+
+// cutFrontMatter splits text following the opening "---" line of front matter
+// at the closing "---" line, returning the front matter and the body after it
+func cutFrontMatter(text string) (frontMatter, body string, found bool) {
+	offset := 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if strings.TrimSuffix(line, "\n") == "---" {
+			return text[:offset], text[offset+len(line):], true
+		}
+		offset += len(line)
+	}
+	return "", "", false
+}
+
+// FindIssueTemplates returns the issue templates of the repo of ctx.
+func FindIssueTemplates(ctx *context.TeaContext) ([]*IssueTemplate, error) {
+	return findTemplates(ctx, "issue_template")
+}
+
+// FindPullTemplates returns the pull request templates of the repo of ctx.
+func FindPullTemplates(ctx *context.TeaContext) ([]*IssueTemplate, error) {
+	return findTemplates(ctx, "pull_request_template")
+}
+
+// GetTemplate returns the template matching name (by template name or file
+// path) among templates.
+func GetTemplate(templates []*IssueTemplate, name string) (*IssueTemplate, error) {
+	for _, t := range templates {
+		if strings.EqualFold(t.Name, name) || t.Path == name ||
+			strings.EqualFold(strings.TrimSuffix(path.Base(t.Path), path.Ext(t.Path)), name) {
+			return t, nil
+		}
+	}
+	names := make([]string, len(templates))
+	for i, t := range templates {
+		names[i] = t.Name
+	}
+	if len(names) == 0 {
+		return nil, utils.NewNotExistErrorf("template '%s' not found, the repo has no templates", name)
+	}
+	return nil, utils.NewNotExistErrorf("template '%s' not found, available: %s", name, strings.Join(names, ", "))
+}
+
+// ApplyTemplate fills properties of opts that are unset with the values
+// from the template. Forms can't be applied non-interactively, unless
+// a body is set already.
+func ApplyTemplate(ctx *context.TeaContext, t *IssueTemplate, opts *gitea.CreateIssueOption) error {
+	if len(opts.Title) == 0 {
+		opts.Title = t.Title
+	}
+	if len(opts.Body) == 0 {
+		if t.IsForm() {
+			for _, f := range t.Fields {
+				if f.Validations.Required {
+					return utils.NewInvalidArgumentErrorf("template '%s' is a form with required fields, fill it in interactively or provide --description", t.Name)
+				}
+			}
+			opts.Body = t.RenderForm(nil)
+		} else {
+			opts.Body = t.Content
+		}
+	}
+
+	assignees := make([]string, 0, len(opts.Assignees)+len(t.Assignees))
+	for _, a := range append(opts.Assignees, t.Assignees...) {
+		if len(a) != 0 && !utils.Contains(assignees, a) {
+			assignees = append(assignees, a)
+		}
+	}
+	opts.Assignees = assignees
+
+	if len(t.Labels) != 0 {
+		labelIDs, err := ResolveLabelNames(ctx.Login.Client(), ctx.Owner, ctx.Repo, t.Labels)
+		if err != nil {
+			return err
+		}
+		for _, id := range labelIDs {
+			if !slices.Contains(opts.Labels, id) {
+				opts.Labels = append(opts.Labels, id)
+			}
+		}
+	}
+	return nil
+}
+
+// findTemplates searches the local checkout for templates with the given base
+// name, if it is a checkout of the repo of ctx. Otherwise it searches
+// the default branch of the remote repo via the API.
+// Templates can be single files named <baseName>.{md,yaml,yml}, or any
+// such files in a directory named <baseName>.
+func findTemplates(ctx *context.TeaContext, baseName string) ([]*IssueTemplate, error) {
+	var fsys templateFS = &apiTemplateFS{client: ctx.Login.Client(), owner: ctx.Owner, repo: ctx.Repo}
+	if ctx.RemoteIsLocalRepo() {
+		wt, err := ctx.LocalRepo.Worktree()
+		if err != nil {
+			return nil, err
+		}
+		fsys = localTemplateFS(wt.Filesystem.Root())
+	}
+
+	var templates []*IssueTemplate
+	for _, dir := range templateBaseDirs {
+		entries, err := fsys.list(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			isDir := strings.HasSuffix(entry, "/")
+			name := strings.TrimSuffix(entry, "/")
+			if !strings.EqualFold(strings.TrimSuffix(name, path.Ext(name)), baseName) {
+				continue
+			}
+
+			var files []string
+			if isDir {
+				subEntries, err := fsys.list(path.Join(dir, name))
+				if err != nil {
+					return nil, err
+				}
+				for _, f := range subEntries {
+					// config.yml configures the template chooser, it is no template
+					if !strings.HasPrefix(strings.ToLower(f), "config.") {
+						files = append(files, path.Join(dir, name, f))
+					}
+				}
+			} else {
+				files = append(files, path.Join(dir, name))
+			}
+
+			for _, f := range files {
+				switch strings.ToLower(path.Ext(f)) {
+				case ".md", ".yaml", ".yml":
+				default:
+					continue
+				}
+				data, err := fsys.read(f)
+				if err != nil {
+					return nil, err
+				}
+				t, err := ParseIssueTemplate(f, data)
+				if err != nil {
+					return nil, err
+				}
+				templates = append(templates, t)
+			}
+		}
+	}
+	return templates, nil
+}
+
+// templateFS abstracts reading templates from a local checkout or the API
+type templateFS interface {
+	// list returns the names of entries in dir, with a trailing slash for
+	// directories. Missing directories have no entries.
+	list(dir string) ([]string, error)
+	read(file string) ([]byte, error)
+}
+
+// localTemplateFS reads templates from the worktree at the given path
+type localTemplateFS string
+
+func (root localTemplateFS) list(dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(string(root), filepath.FromSlash(dir)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+		if e.IsDir() {
+			names[i] += "/"
+		}
+	}
+	return names, nil
+}
+
+func (root localTemplateFS) read(file string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(string(root), filepath.FromSlash(file)))
+}
+
+// apiTemplateFS reads templates from the default branch of a remote repo
+type apiTemplateFS struct {
+	client      *gitea.Client
+	owner, repo string
+}
+
+func (a *apiTemplateFS) list(dir string) ([]string, error) {
+	contents, resp, err := a.client.ListContents(a.owner, a.repo, "", dir)
+	if resp != nil && resp.StatusCode == 404 {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(contents))
+	for i, c := range contents {
+		names[i] = c.Name
+		if c.Type == "dir" {
+			names[i] += "/"
+		}
+	}
+	return names, nil
+}
+
+func (a *apiTemplateFS) read(file string) ([]byte, error) {
+	data, _, err := a.client.GetFile(a.owner, a.repo, "", file)
+	return data, err
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIssueTemplate(t *testing.T) {
+	md, err := ParseIssueTemplate(".gitea/ISSUE_TEMPLATE/bug.md", []byte(`---
+name: Bug report
+title: "[BUG] "
+labels: bug, needs triage
+---
+## Steps
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug report", md.Name)
+	assert.Equal(t, "[BUG] ", md.Title)
+	assert.EqualValues(t, []string{"bug", "needs triage"}, md.Labels)
+	assert.Equal(t, "## Steps\n", md.Content)
+	assert.False(t, md.IsForm())
+
+	empty, err := ParseIssueTemplate("bug.md", []byte("---\n---\nDescribe the bug\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "bug", empty.Name)
+	assert.Equal(t, "Describe the bug\n", empty.Content)
+
+	rules, err := ParseIssueTemplate("rules.md", []byte("---\nname: Rules\nabout: ---not a delimiter\n---\nAbove\n---\nBelow\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Rules", rules.Name)
+	assert.Equal(t, "---not a delimiter", rules.About)
+	assert.Equal(t, "Above\n---\nBelow\n", rules.Content)
+
+	_, err = ParseIssueTemplate("broken.md", []byte("---\nname: Broken\n----\n"))
+	assert.Error(t, err)
+
+	plain, err := ParseIssueTemplate("PULL_REQUEST_TEMPLATE.md", []byte("Fixes #"))
+	assert.NoError(t, err)
+	assert.Equal(t, "PULL_REQUEST_TEMPLATE", plain.Name)
+	assert.Equal(t, "Fixes #", plain.Content)
+
+	form, err := ParseIssueTemplate("issue_template.yaml", []byte(`
+name: Feature
+labels: [enhancement]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks!
+  - type: input
+    attributes:
+      label: Version
+    validations:
+      required: true
+      is_number: true
+  - type: dropdown
+    attributes:
+      label: Area
+      options: [cli, api]
+  - type: textarea
+    attributes:
+      label: Logs
+      render: shell
+  - type: checkboxes
+    attributes:
+      label: Terms
+      options:
+        - label: I searched existing issues
+          required: true
+        - label: I want to help
+`))
+	assert.NoError(t, err)
+	assert.True(t, form.IsForm())
+	assert.EqualValues(t, []string{"enhancement"}, form.Labels)
+	assert.Equal(t, "api", form.Fields[2].Attributes.Options[1].Label)
+	assert.True(t, form.Fields[4].Attributes.Options[0].Required)
+
+	assert.Error(t, form.Fields[1].Validate(""))
+	assert.Error(t, form.Fields[1].Validate("abc"))
+	assert.NoError(t, form.Fields[1].Validate("1.21"))
+
+	assert.Equal(t, `### Version
+
+1.21
+
+### Area
+
+_No response_
+
+### Logs
+
+`+"```shell\npanic\n```"+`
+
+### Terms
+
+- [x] I searched existing issues
+- [ ] I want to help`, form.RenderForm([][]string{
+		nil,
+		{"1.21"},
+		nil,
+		{"panic"},
+		{"I searched existing issues"},
+	}))
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/api"
+	"code.gitea.io/tea/modules/config"
+)
+
+// timelineCategories groups the event types of the timeline API into the
+// categories which may be used to filter the timeline.
+var timelineCategories = map[string][]string{
+	"comment":    {"comment"},
+	"state":      {"close", "reopen", "merge_pull"},
+	"ref":        {"issue_ref", "commit_ref", "comment_ref", "pull_ref", "change_issue_ref"},
+	"label":      {"label"},
+	"milestone":  {"milestone"},
+	"assignee":   {"assignees"},
+	"title":      {"change_title"},
+	"review":     {"review", "code", "review_request", "dismiss_review"},
+	"branch":     {"delete_branch", "change_target_branch", "pull_push"},
+	"deadline":   {"added_deadline", "modified_deadline", "removed_deadline"},
+	"time":       {"start_tracking", "stop_tracking", "add_time_manual", "cancel_tracking", "delete_time_manual"},
+	"dependency": {"add_dependency", "remove_dependency"},
+	"lock":       {"lock", "unlock"},
+	"pin":        {"pin", "unpin"},
+	"project":    {"project", "project_board"},
+}
+
+// TimelineCategories lists the categories events can be filtered by
+var TimelineCategories = func() []string {
+	categories := make([]string, 0, len(timelineCategories))
+	for c := range timelineCategories {
+		categories = append(categories, c)
+	}
+	sort.Strings(categories)
+	return categories
+}()
+
+// timelineEventInCategory reports whether the event belongs to category,
+// one of TimelineCategories
+func timelineEventInCategory(e *api.TimelineEvent, category string) bool {
+	for _, t := range timelineCategories[category] {
+		if e.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// ListIssueTimeline returns all events on the timeline of an issue or pull
+// request in chronological order. If since is set, only later events are returned.
+func ListIssueTimeline(login *config.Login, owner, repo string, index int64, since time.Time) ([]*api.TimelineEvent, error) {
+	query := url.Values{}
+	if !since.IsZero() {
+		query.Set("since", since.Format(time.RFC3339))
+	}
+
+	events, err := ListAllPages(func(opt gitea.ListOptions) ([]*api.TimelineEvent, *gitea.Response, error) {
+		query.Set("page", fmt.Sprint(opt.Page))
+		query.Set("limit", fmt.Sprint(opt.PageSize))
+		var batch []*api.TimelineEvent
+		resp, err := login.APIRequest(http.MethodGet, issuePath(owner, repo, index, "/timeline")+"?"+query.Encode(), nil, &batch)
+		return batch, resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load timeline of #%d: %w", index, err)
+	}
+	return events, nil
+}
+
+// FilterTimeline returns the events in any of categories (see
+// TimelineCategories). If no categories are given, all events are returned.
+func FilterTimeline(events []*api.TimelineEvent, categories []string) []*api.TimelineEvent {
+	if len(categories) == 0 {
+		return events
+	}
+	var filtered []*api.TimelineEvent
+	for _, e := range events {
+		for _, c := range categories {
+			if timelineEventInCategory(e, c) {
+				filtered = append(filtered, e)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"code.gitea.io/tea/modules/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterTimeline(t *testing.T) {
+	reopen := &api.TimelineEvent{Type: "reopen"}
+	comment := &api.TimelineEvent{Type: "comment"}
+	events := []*api.TimelineEvent{reopen, comment}
+
+	assert.True(t, timelineEventInCategory(reopen, "state"))
+	assert.False(t, timelineEventInCategory(reopen, "comment"))
+	assert.False(t, timelineEventInCategory(reopen, "reopen"))
+
+	assert.Equal(t, events, FilterTimeline(events, nil))
+	assert.Equal(t, []*api.TimelineEvent{reopen}, FilterTimeline(events, []string{"state"}))
+	assert.Equal(t, []*api.TimelineEvent{reopen, comment}, FilterTimeline(events, []string{"comment", "state"}))
+}
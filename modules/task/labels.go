@@ -5,17 +5,18 @@ package task
 
 import (
 	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/utils"
 )
 
 // ResolveLabelNames returns a list of label IDs for a given list of label names
 func ResolveLabelNames(client *gitea.Client, owner, repo string, labelNames []string) ([]int64, error) {
 	labelIDs := make([]int64, 0, len(labelNames))
-	labels, _, err := client.ListRepoLabels(owner, repo, gitea.ListLabelsOptions{
+	labels, resp, err := client.ListRepoLabels(owner, repo, gitea.ListLabelsOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return nil, err
+		return nil, config.ClassifyAPIError(resp, err)
 	}
 	for _, l := range labels {
 		if utils.Contains(labelNames, l.Name) {
@@ -62,16 +62,20 @@ func CreateLogin(name, token, user, passwd, otp, scopes, sshKey, giteaURL, sshCe
 	// checks ...
 	// ... if we have a url
 	if len(giteaURL) == 0 {
-		return fmt.Errorf("You have to input Gitea server URL")
+		return utils.NewInvalidArgumentErrorf("You have to input Gitea server URL")
 	}
 
 	// ... if there already exist a login with same name
-	if login := config.GetLoginByName(name); login != nil {
-		return fmt.Errorf("login name '%s' has already been used", login.Name)
+	if login, err := config.GetLoginByName(name); err != nil {
+		return err
+	} else if login != nil {
+		return utils.NewAlreadyExistErrorf("login name '%s' has already been used", login.Name)
 	}
 	// ... if we already use this token
-	if login := config.GetLoginByToken(token); login != nil {
-		return fmt.Errorf("token already been used, delete login '%s' first", login.Name)
+	if login, err := config.GetLoginByToken(token); err != nil {
+		return err
+	} else if login != nil {
+		return utils.NewAlreadyExistErrorf("token already been used, delete login '%s' first", login.Name)
 	}
 
 	if !sshAgent && sshCertPrincipal == "" && sshKey == "" {
@@ -88,7 +92,7 @@ func CreateLogin(name, token, user, passwd, otp, scopes, sshKey, giteaURL, sshCe
 	// Normalize URL
 	serverURL, err := utils.NormalizeURL(giteaURL)
 	if err != nil {
-		return fmt.Errorf("Unable to parse URL: %s", err)
+		return fmt.Errorf("Unable to parse URL: %w", err)
 	}
 
 	// check if it's a certificate the principal doesn't matter as the user
@@ -119,9 +123,9 @@ func CreateLogin(name, token, user, passwd, otp, scopes, sshKey, giteaURL, sshCe
 	client := login.Client()
 
 	// Verify if authentication works and get user info
-	u, _, err := client.GetMyUserInfo()
+	u, resp, err := client.GetMyUserInfo()
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	login.User = u.UserName
 
@@ -164,11 +168,11 @@ func generateToken(login config.Login, user, pass, otp, scopes string) (string,
 	}
 	client := login.Client(opts...)
 
-	tl, _, err := client.ListAccessTokens(gitea.ListAccessTokensOptions{
+	tl, resp, err := client.ListAccessTokens(gitea.ListAccessTokensOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
-		return "", err
+		return "", config.ClassifyAPIError(resp, err)
 	}
 	host, _ := os.Hostname()
 	tokenName := host + "-tea"
@@ -203,7 +207,9 @@ func GenerateLoginName(url, user string) (string, error) {
 
 	// append user name if login name already exists
 	if len(user) != 0 {
-		if login := config.GetLoginByName(name); login != nil {
+		if login, err := config.GetLoginByName(name); err != nil {
+			return "", err
+		} else if login != nil {
 			return name + "_" + user, nil
 		}
 	}
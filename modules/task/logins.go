@@ -0,0 +1,28 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"sync"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+)
+
+// ForEachLogin runs list for all logins concurrently, and returns the
+// results in the order of logins.
+func ForEachLogin[T any](logins []*config.Login, list func(login *config.Login) ([]T, error)) []print.LoginItems[T] {
+	results := make([]print.LoginItems[T], len(logins))
+	var wg sync.WaitGroup
+	for i, login := range logins {
+		wg.Add(1)
+		go func(i int, login *config.Login) {
+			defer wg.Done()
+			items, err := list(login)
+			results[i] = print.LoginItems[T]{Login: login.Name, Items: items, Err: err}
+		}(i, login)
+	}
+	wg.Wait()
+	return results
+}
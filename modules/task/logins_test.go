@@ -0,0 +1,31 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachLogin(t *testing.T) {
+	logins := []*config.Login{{Name: "work"}, {Name: "home"}, {Name: "broken"}}
+	errFailed := errors.New("failed")
+
+	results := ForEachLogin(logins, func(login *config.Login) ([]string, error) {
+		if login.Name == "broken" {
+			return nil, errFailed
+		}
+		return []string{login.Name + "/a", login.Name + "/b"}, nil
+	})
+	assert.Equal(t, []print.LoginItems[string]{
+		{Login: "work", Items: []string{"work/a", "work/b"}},
+		{Login: "home", Items: []string{"home/a", "home/b"}},
+		{Login: "broken", Err: errFailed},
+	}, results)
+}
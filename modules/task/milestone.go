@@ -0,0 +1,27 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
+)
+
+// GetMilestoneByName looks up a milestone of a repo by its name. It is
+// reported as not found only if the server responds so, other errors are
+// classified by their response.
+func GetMilestoneByName(client *gitea.Client, owner, repo, name string) (*gitea.Milestone, error) {
+	ms, resp, err := client.GetMilestoneByName(owner, repo, name)
+	if err == nil {
+		return ms, nil
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, utils.NewNotExistErrorf("Milestone '%s' not found", name)
+	}
+	return nil, fmt.Errorf("could not look up milestone '%s': %w", name, config.ClassifyAPIError(resp, err))
+}
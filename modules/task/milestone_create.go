@@ -21,14 +21,14 @@ func CreateMilestone(login *config.Login, repoOwner, repoName, title, descriptio
 		return fmt.Errorf("Title is required")
 	}
 
-	mile, _, err := login.Client().CreateMilestone(repoOwner, repoName, gitea.CreateMilestoneOption{
+	mile, resp, err := login.Client().CreateMilestone(repoOwner, repoName, gitea.CreateMilestoneOption{
 		Title:       title,
 		Description: description,
 		Deadline:    deadline,
 		State:       state,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	print.MilestoneDetails(mile)
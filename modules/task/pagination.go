@@ -0,0 +1,40 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+)
+
+// ForEachPage calls list for each page of a listing, and visit with its items,
+// until visit returns false or there are no more pages. Pages are followed by
+// the pagination links of the responses, as the server may return fewer items
+// per page than requested.
+func ForEachPage[T any](list func(opt gitea.ListOptions) ([]T, *gitea.Response, error), visit func(items []T) bool) error {
+	opt := gitea.ListOptions{Page: 1, PageSize: 50}
+	for {
+		items, resp, err := list(opt)
+		if err != nil {
+			return config.ClassifyAPIError(resp, err)
+		}
+		if !visit(items) || resp == nil || resp.NextPage <= opt.Page {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// ListAllPages returns the items of all pages of a listing, see ForEachPage
+func ListAllPages[T any](list func(opt gitea.ListOptions) ([]T, *gitea.Response, error)) ([]T, error) {
+	var all []T
+	err := ForEachPage(list, func(items []T) bool {
+		all = append(all, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
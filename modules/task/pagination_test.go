@@ -0,0 +1,41 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"net/http"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllPages(t *testing.T) {
+	// the server returns 2 items per page, less than requested
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+	var requested []int
+	list := func(opt gitea.ListOptions) ([]int, *gitea.Response, error) {
+		requested = append(requested, opt.Page)
+		resp := &gitea.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+		if opt.Page < len(pages) {
+			resp.NextPage = opt.Page + 1
+		}
+		return pages[opt.Page-1], resp, nil
+	}
+
+	items, err := ListAllPages(list)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, []int{1, 2, 3}, requested)
+
+	requested = nil
+	var visited []int
+	err = ForEachPage(list, func(items []int) bool {
+		visited = append(visited, items...)
+		return len(visited) < 3
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+	assert.Equal(t, []int{1, 2}, requested)
+}
@@ -9,6 +9,7 @@ import (
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/config"
 	local_git "code.gitea.io/tea/modules/git"
+	"code.gitea.io/tea/modules/utils"
 	"code.gitea.io/tea/modules/workaround"
 
 	"github.com/go-git/go-git/v5"
@@ -25,9 +26,9 @@ func PullCheckout(
 	callback func(string) (string, error),
 ) error {
 	client := login.Client()
-	pr, _, err := client.GetPullRequest(repoOwner, repoName, index)
+	pr, resp, err := client.GetPullRequest(repoOwner, repoName, index)
 	if err != nil {
-		return fmt.Errorf("couldn't fetch PR: %s", err)
+		return fmt.Errorf("couldn't fetch PR: %w", config.ClassifyAPIError(resp, err))
 	}
 	if err := workaround.FixPullHeadSha(client, pr); err != nil {
 		return err
@@ -106,6 +107,9 @@ func doPRFetch(
 			localBranchName,
 		))}
 	}
+	if utils.SkipDryRun("git: fetch PR %v (head %s:%s) from remote '%s'", pr.Index, url, pr.Head.Ref, localRemoteName) {
+		return localBranchName, nil
+	}
 	fmt.Printf("Fetching PR %v (head %s:%s) from remote '%s'\n", pr.Index, url, pr.Head.Ref, localRemoteName)
 
 	err = localRemote.Fetch(fetchOpts)
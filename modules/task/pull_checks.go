@@ -0,0 +1,173 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+	"code.gitea.io/tea/modules/utils"
+)
+
+// FindPullForBranch returns the open pull request whose head is branch
+func FindPullForBranch(login *config.Login, owner, repo, branch string) (*gitea.PullRequest, error) {
+	client := login.Client()
+	var found *gitea.PullRequest
+	err := ForEachPage(func(opt gitea.ListOptions) ([]*gitea.PullRequest, *gitea.Response, error) {
+		return client.ListRepoPullRequests(owner, repo, gitea.ListPullRequestsOptions{ListOptions: opt, State: gitea.StateOpen})
+	}, func(prs []*gitea.PullRequest) bool {
+		for _, pr := range prs {
+			if pr.Head != nil && pr.Head.Ref == branch {
+				found = pr
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, utils.NewNotExistErrorf("no open pull request for branch '%s'", branch)
+	}
+	return found, nil
+}
+
+// ListPullChecks returns the latest state of each status context of the head
+// of a pull request, sorted by context. Contexts required by the branch
+// protection of the base branch are marked, and expected ones which did not
+// report yet are returned as pending. If requiredOnly is set, other contexts
+// are left out, and it fails if no checks are required.
+func ListPullChecks(login *config.Login, owner, repo string, pr *gitea.PullRequest, requiredOnly bool) ([]*print.PullCheck, error) {
+	var protection *gitea.BranchProtection
+	if pr.Base != nil {
+		var err error
+		if protection, err = getBranchProtection(login, owner, repo, pr.Base.Ref); err != nil && requiredOnly {
+			return nil, err
+		}
+	}
+	if requiredOnly && (protection == nil || !protection.EnableStatusCheck || len(protection.StatusCheckContexts) == 0) {
+		// otherwise no checks would be listed, which looks like they all passed
+		base := ""
+		if pr.Base != nil {
+			base = pr.Base.Ref
+		}
+		return nil, utils.NewNotExistErrorf("no required checks configured for branch '%s'", base)
+	}
+	return listPullChecks(login, owner, repo, pr, protection, requiredOnly)
+}
+
+// listPullChecks is ListPullChecks with the branch protection of the base
+// branch, which may be nil
+func listPullChecks(login *config.Login, owner, repo string, pr *gitea.PullRequest, protection *gitea.BranchProtection, requiredOnly bool) ([]*print.PullCheck, error) {
+	client := login.Client()
+	statuses, err := ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Status, *gitea.Response, error) {
+		return client.ListStatuses(owner, repo, pr.Head.Sha, gitea.ListStatusesOption{ListOptions: opt})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load statuses of #%d: %w", pr.Index, err)
+	}
+
+	var required []string
+	if protection != nil && protection.EnableStatusCheck {
+		required = protection.StatusCheckContexts
+	}
+	return mergeStatuses(statuses, required, requiredOnly), nil
+}
+
+// mergeStatuses merges all statuses of a commit into one check per context
+func mergeStatuses(statuses []*gitea.Status, required []string, requiredOnly bool) []*print.PullCheck {
+	patterns := make([]*regexp.Regexp, len(required))
+	for i, r := range required {
+		patterns[i] = globToRegexp(r)
+	}
+	matched := make([]bool, len(required))
+
+	byContext := map[string]*print.PullCheck{}
+	latest := map[string]*gitea.Status{}
+	for _, s := range statuses {
+		c, ok := byContext[s.Context]
+		if !ok {
+			c = &print.PullCheck{Context: s.Context, Started: s.Created}
+			for i, p := range patterns {
+				if p.MatchString(s.Context) {
+					c.Required = true
+					matched[i] = true
+				}
+			}
+			byContext[s.Context] = c
+		}
+		if s.Created.Before(c.Started) {
+			c.Started = s.Created
+		}
+		if l, ok := latest[s.Context]; !ok || s.Updated.After(l.Updated) || (s.Updated.Equal(l.Updated) && s.ID > l.ID) {
+			latest[s.Context] = s
+		}
+	}
+
+	var checks []*print.PullCheck
+	for name, c := range byContext {
+		if requiredOnly && !c.Required {
+			continue
+		}
+		s := latest[name]
+		c.State = s.State
+		c.Description = s.Description
+		c.URL = s.TargetURL
+		if s.State != gitea.StatusPending {
+			c.Finished = s.Updated
+		}
+		checks = append(checks, c)
+	}
+	for i, r := range required {
+		if !matched[i] {
+			checks = append(checks, &print.PullCheck{
+				Context:     r,
+				State:       gitea.StatusPending,
+				Description: "expected, has not reported yet",
+				Required:    true,
+			})
+		}
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Context < checks[j].Context })
+	return checks
+}
+
+// getBranchProtection returns the protection rule applying to branch, or nil
+func getBranchProtection(login *config.Login, owner, repo, branch string) (*gitea.BranchProtection, error) {
+	client := login.Client()
+	protections, err := ListAllPages(func(opt gitea.ListOptions) ([]*gitea.BranchProtection, *gitea.Response, error) {
+		return client.ListBranchProtections(owner, repo, gitea.ListBranchProtectionsOptions{ListOptions: opt})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load branch protections, which needs admin access to the repo: %w", err)
+	}
+	var match *gitea.BranchProtection
+	for _, p := range protections {
+		name := p.RuleName
+		if name == "" {
+			name = p.BranchName
+		}
+		if name == branch {
+			return p, nil
+		}
+		if match == nil && globToRegexp(name).MatchString(branch) {
+			match = p
+		}
+	}
+	return match, nil
+}
+
+// globToRegexp compiles a glob pattern as used by branch protection, where
+// * and ? match within a path segment, and ** matches across '/'
+func globToRegexp(pattern string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.NewReplacer(`\*\*`, `.*`, `\*`, `[^/]*`, `\?`, `[^/]`).Replace(quoted)
+	return regexp.MustCompile("^" + quoted + "$")
+}
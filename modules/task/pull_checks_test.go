@@ -0,0 +1,50 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeStatuses(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	statuses := []*gitea.Status{
+		{ID: 2, Context: "ci/build", State: gitea.StatusSuccess, Created: start.Add(time.Minute), Updated: start.Add(2 * time.Minute)},
+		{ID: 1, Context: "ci/build", State: gitea.StatusPending, Created: start, Updated: start},
+		{ID: 3, Context: "lint", State: gitea.StatusPending, Created: start, Updated: start},
+	}
+
+	checks := mergeStatuses(statuses, []string{"ci/*", "deploy"}, false)
+	if assert.Len(t, checks, 3) {
+		assert.Equal(t, "ci/build", checks[0].Context)
+		assert.Equal(t, gitea.StatusSuccess, checks[0].State)
+		assert.True(t, checks[0].Required)
+		assert.Equal(t, 2*time.Minute, checks[0].Duration())
+		assert.Equal(t, "deploy", checks[1].Context)
+		assert.Equal(t, gitea.StatusPending, checks[1].State)
+		assert.True(t, checks[1].Required)
+		assert.Equal(t, "lint", checks[2].Context)
+		assert.False(t, checks[2].Required)
+		assert.True(t, checks[2].Finished.IsZero())
+	}
+
+	checks = mergeStatuses(statuses, []string{"ci/*"}, true)
+	if assert.Len(t, checks, 1) {
+		assert.Equal(t, "ci/build", checks[0].Context)
+	}
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	assert.True(t, globToRegexp("release/*").MatchString("release/1.2"))
+	assert.False(t, globToRegexp("release/*").MatchString("release/1.2/rc"))
+	assert.True(t, globToRegexp("release/**").MatchString("release/1.2/rc"))
+	assert.True(t, globToRegexp("v?.x").MatchString("v1.x"))
+	assert.False(t, globToRegexp("a?b").MatchString("a/b"))
+	assert.False(t, globToRegexp("main").MatchString("maintenance"))
+	assert.False(t, globToRegexp("a.b").MatchString("aXb"))
+}
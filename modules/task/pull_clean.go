@@ -19,9 +19,9 @@ import (
 func PullClean(login *config.Login, repoOwner, repoName string, index int64, ignoreSHA bool, callback func(string) (string, error)) error {
 	client := login.Client()
 
-	repo, _, err := client.GetRepo(repoOwner, repoName)
+	repo, resp, err := client.GetRepo(repoOwner, repoName)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	defaultBranch := repo.DefaultBranch
 	if len(defaultBranch) == 0 {
@@ -29,9 +29,9 @@ func PullClean(login *config.Login, repoOwner, repoName string, index int64, ign
 	}
 
 	// fetch PR source-repo & -branch from gitea
-	pr, _, err := client.GetPullRequest(repoOwner, repoName, index)
+	pr, resp, err := client.GetPullRequest(repoOwner, repoName, index)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	if err := workaround.FixPullHeadSha(client, pr); err != nil {
 		return err
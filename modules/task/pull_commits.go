@@ -0,0 +1,25 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+)
+
+// ListPullCommits returns all commits of a pull request
+func ListPullCommits(login *config.Login, owner, repo string, index int64) ([]*gitea.Commit, error) {
+	client := login.Client()
+	return ListAllPages(func(opt gitea.ListOptions) ([]*gitea.Commit, *gitea.Response, error) {
+		return client.ListPullRequestCommits(owner, repo, index, gitea.ListPullRequestCommitsOptions{ListOptions: opt})
+	})
+}
+
+// ListPullFiles returns all files changed by a pull request
+func ListPullFiles(login *config.Login, owner, repo string, index int64) ([]*gitea.ChangedFile, error) {
+	client := login.Client()
+	return ListAllPages(func(opt gitea.ListOptions) ([]*gitea.ChangedFile, *gitea.Response, error) {
+		return client.ListPullRequestFiles(owner, repo, index, gitea.ListPullRequestFilesOptions{ListOptions: opt})
+	})
+}
@@ -23,23 +23,36 @@ var (
 )
 
 // CreatePull creates a PR in the given repo and prints the result
-func CreatePull(ctx *context.TeaContext, base, head string, allowMaintainerEdits bool, opts *gitea.CreateIssueOption) (err error) {
+func CreatePull(ctx *context.TeaContext, base, head string, allowMaintainerEdits bool, opts *gitea.CreateIssueOption) error {
+	pr, err := createPull(ctx, base, head, allowMaintainerEdits, opts)
+	if err != nil {
+		return err
+	}
+
+	print.PullDetails(pr, nil, nil, nil, nil)
+
+	fmt.Println(pr.HTMLURL)
+
+	return nil
+}
+
+func createPull(ctx *context.TeaContext, base, head string, allowMaintainerEdits bool, opts *gitea.CreateIssueOption) (pr *gitea.PullRequest, err error) {
 	// default is default branch
 	if len(base) == 0 {
 		base, err = GetDefaultPRBase(ctx.Login, ctx.Owner, ctx.Repo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// default is current one
 	if len(head) == 0 {
 		if ctx.LocalRepo == nil {
-			return fmt.Errorf("no local git repo detected, please specify head branch")
+			return nil, fmt.Errorf("no local git repo detected, please specify head branch")
 		}
 		headOwner, headBranch, err := GetDefaultPRHead(ctx.LocalRepo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		head = GetHeadSpec(headOwner, headBranch, ctx.Owner)
@@ -47,7 +60,7 @@ func CreatePull(ctx *context.TeaContext, base, head string, allowMaintainerEdits
 
 	// head & base may not be the same
 	if head == base {
-		return fmt.Errorf("can't create PR from %s to %s", head, base)
+		return nil, fmt.Errorf("can't create PR from %s to %s", head, base)
 	}
 
 	// default is head branch name
@@ -56,12 +69,12 @@ func CreatePull(ctx *context.TeaContext, base, head string, allowMaintainerEdits
 	}
 	// title is required
 	if len(opts.Title) == 0 {
-		return fmt.Errorf("title is required")
+		return nil, fmt.Errorf("title is required")
 	}
 
 	client := ctx.Login.Client()
 
-	pr, _, err := client.CreatePullRequest(ctx.Owner, ctx.Repo, gitea.CreatePullRequestOption{
+	pr, resp, err := client.CreatePullRequest(ctx.Owner, ctx.Repo, gitea.CreatePullRequestOption{
 		Head:      head,
 		Base:      base,
 		Title:     opts.Title,
@@ -72,30 +85,26 @@ func CreatePull(ctx *context.TeaContext, base, head string, allowMaintainerEdits
 		Deadline:  opts.Deadline,
 	})
 	if err != nil {
-		return fmt.Errorf("could not create PR from %s to %s:%s: %s", head, ctx.Owner, base, err)
+		return nil, fmt.Errorf("could not create PR from %s to %s:%s: %w", head, ctx.Owner, base, config.ClassifyAPIError(resp, err))
 	}
 
 	if pr.AllowMaintainerEdit != allowMaintainerEdits {
-		pr, _, err = client.EditPullRequest(ctx.Owner, ctx.Repo, pr.Index, gitea.EditPullRequestOption{
+		pr, resp, err = client.EditPullRequest(ctx.Owner, ctx.Repo, pr.Index, gitea.EditPullRequestOption{
 			AllowMaintainerEdit: gitea.OptionalBool(allowMaintainerEdits),
 		})
 		if err != nil {
-			return fmt.Errorf("could not enable maintainer edit on pull: %v", err)
+			return nil, fmt.Errorf("could not enable maintainer edit on pull: %w", config.ClassifyAPIError(resp, err))
 		}
 	}
 
-	print.PullDetails(pr, nil, nil)
-
-	fmt.Println(pr.HTMLURL)
-
-	return err
+	return pr, nil
 }
 
 // GetDefaultPRBase retrieves the default base branch for the given repo
 func GetDefaultPRBase(login *config.Login, owner, repo string) (string, error) {
-	meta, _, err := login.Client().GetRepo(owner, repo)
+	meta, resp, err := login.Client().GetRepo(owner, repo)
 	if err != nil {
-		return "", fmt.Errorf("could not fetch repo meta: %s", err)
+		return "", fmt.Errorf("could not fetch repo meta: %w", config.ClassifyAPIError(resp, err))
 	}
 	return meta.DefaultBranch, nil
 }
@@ -111,7 +120,7 @@ func GetDefaultPRHead(localRepo *local_git.TeaRepo) (owner, branch string, err e
 
 	remote, err := localRepo.TeaFindBranchRemote(branch, sha)
 	if err != nil {
-		err = fmt.Errorf("could not determine remote for current branch: %s", err)
+		err = fmt.Errorf("could not determine remote for current branch: %w", err)
 		return
 	}
 
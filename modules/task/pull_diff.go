@@ -0,0 +1,117 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"path"
+	"strings"
+
+	"code.gitea.io/tea/modules/print"
+)
+
+// ParseDiff splits a unified diff as generated by git into its files
+func ParseDiff(diff string) []*print.DiffFile {
+	var files []*print.DiffFile
+	var file *print.DiffFile
+	var inHunk bool
+	var text strings.Builder
+	flush := func() {
+		if file != nil {
+			file.Text = text.String()
+			files = append(files, file)
+		}
+		text.Reset()
+	}
+
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		content := strings.TrimRight(line, "\n")
+		if strings.HasPrefix(content, "diff --git ") {
+			flush()
+			file = &print.DiffFile{}
+			inHunk = false
+			// "diff --git a/<old> b/<new>", only ambiguous if paths contain " b/"
+			if i := strings.LastIndex(content, " b/"); i >= 0 {
+				file.Path = content[i+len(" b/"):]
+				file.OldPath = strings.TrimPrefix(content[len("diff --git "):i], "a/")
+			}
+		}
+		if file == nil {
+			// skip anything before the first file
+			continue
+		}
+		text.WriteString(line)
+
+		switch {
+		case strings.HasPrefix(content, "@@"):
+			inHunk = true
+		case inHunk && strings.HasPrefix(content, "+"):
+			file.Additions++
+		case inHunk && strings.HasPrefix(content, "-"):
+			file.Deletions++
+		case inHunk:
+		case strings.HasPrefix(content, "rename from "):
+			file.OldPath = strings.TrimPrefix(content, "rename from ")
+		case strings.HasPrefix(content, "rename to "):
+			file.Path = strings.TrimPrefix(content, "rename to ")
+		case strings.HasPrefix(content, "--- a/"):
+			file.OldPath = strings.TrimPrefix(content, "--- a/")
+		case strings.HasPrefix(content, "+++ b/"):
+			file.Path = strings.TrimPrefix(content, "+++ b/")
+		case strings.HasPrefix(content, "new file mode"):
+			file.Status = "added"
+		case strings.HasPrefix(content, "deleted file mode"):
+			file.Status = "deleted"
+		case strings.HasPrefix(content, "Binary files ") || content == "GIT binary patch":
+			file.Binary = true
+		}
+	}
+	flush()
+
+	for _, f := range files {
+		if f.Status == "" {
+			f.Status = "modified"
+			if f.OldPath != f.Path {
+				f.Status = "renamed"
+			}
+		}
+	}
+	return files
+}
+
+// FilterDiff returns the files matching any of paths, which may be file
+// paths, directories or glob patterns. All files are returned if paths is empty.
+func FilterDiff(files []*print.DiffFile, paths []string) []*print.DiffFile {
+	if len(paths) == 0 {
+		return files
+	}
+	var filtered []*print.DiffFile
+	for _, f := range files {
+		for _, p := range paths {
+			if matchesPath(f.Path, p) || (f.OldPath != f.Path && matchesPath(f.OldPath, p)) {
+				filtered = append(filtered, f)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func matchesPath(file, pattern string) bool {
+	pattern = strings.TrimPrefix(strings.TrimSuffix(pattern, "/"), "./")
+	if file == pattern || strings.HasPrefix(file, pattern+"/") {
+		return true
+	}
+	if ok, _ := path.Match(pattern, file); ok {
+		return true
+	}
+	// patterns without directory match the file name anywhere, like *.go
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(file))
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDiff = `diff --git a/main.go b/main.go
+index 1..2 100644
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+ package main
+-// old
++// new
+--- not a header
+diff --git a/docs/a.md b/docs/b.md
+similarity index 90%
+rename from docs/a.md
+rename to docs/b.md
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+--- a/gone.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-x
+diff --git a/img.png b/img.png
+Binary files a/img.png and b/img.png differ
+`
+
+func TestParseDiff(t *testing.T) {
+	files := ParseDiff(testDiff)
+	if !assert.Len(t, files, 4) {
+		return
+	}
+
+	assert.Equal(t, "main.go", files[0].Path)
+	assert.Equal(t, "modified", files[0].Status)
+	assert.Equal(t, 1, files[0].Additions)
+	assert.Equal(t, 2, files[0].Deletions)
+
+	assert.Equal(t, "docs/b.md", files[1].Path)
+	assert.Equal(t, "docs/a.md", files[1].OldPath)
+	assert.Equal(t, "renamed", files[1].Status)
+
+	assert.Equal(t, "gone.txt", files[2].Path)
+	assert.Equal(t, "deleted", files[2].Status)
+	assert.Equal(t, 1, files[2].Deletions)
+
+	assert.True(t, files[3].Binary)
+	assert.Equal(t, testDiff, files[0].Text+files[1].Text+files[2].Text+files[3].Text)
+}
+
+func TestFilterDiff(t *testing.T) {
+	files := ParseDiff(testDiff)
+	paths := func(patterns ...string) []string {
+		var result []string
+		for _, f := range FilterDiff(files, patterns) {
+			result = append(result, f.Path)
+		}
+		return result
+	}
+
+	assert.Len(t, paths(), 4)
+	assert.Equal(t, []string{"docs/b.md"}, paths("docs/"))
+	assert.Equal(t, []string{"docs/b.md"}, paths("docs/a.md"))
+	assert.Equal(t, []string{"main.go", "img.png"}, paths("*.go", "./img.png"))
+	assert.Equal(t, []string{"docs/b.md"}, paths("docs/*.md"))
+	assert.Empty(t, paths("doc"))
+}
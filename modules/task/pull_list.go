@@ -0,0 +1,271 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+)
+
+// draftPrefixes are the title prefixes by which gitea marks pull requests as
+// work in progress, compared case insensitively
+var draftPrefixes = []string{"WIP:", "[WIP]"}
+
+// PullFilter holds filters for pull requests which the API does not support,
+// and are applied client side. Users may be given as '@me' for the login user.
+type PullFilter struct {
+	Author string
+	// Labels are label names, which a pull request must all have
+	Labels []string
+	Base   string
+	Head   string
+	// Reviewer is a user who submitted a review, ReviewRequested a user whose review is pending
+	Reviewer        string
+	ReviewRequested string
+	Draft           *bool
+	Mergeable       *bool
+	// CI is the combined commit status state, or "none" for no statuses
+	CI string
+}
+
+// defaultPullsLimit is the number of pull requests ListPulls returns if no
+// limit is given, the default page size of the API
+const defaultPullsLimit = 30
+
+// listPullsConcurrency limits the number of parallel requests of listPullExtras
+const listPullsConcurrency = 4
+
+// ListPulls lists the pull requests of a repo matching opt and filter, and in
+// milestone if it is not empty. It returns the extras needed by filter or by
+// fields ("ci", "reviews").
+// With client side filters, pages of the API are fetched until the page of
+// opt holds opt.PageSize matching pull requests, or all are exhausted.
+func ListPulls(login *config.Login, owner, repo, milestone string, opt gitea.ListPullRequestsOptions, filter PullFilter, fields []string) ([]*gitea.PullRequest, map[*gitea.PullRequest]*print.PullExtras, error) {
+	client := login.Client()
+	if milestone != "" {
+		ms, err := GetMilestoneByName(client, owner, repo, milestone)
+		if err != nil {
+			return nil, nil, err
+		}
+		opt.Milestone = ms.ID
+	}
+
+	needCI := filter.CI != ""
+	needReviews := filter.Reviewer != "" || filter.ReviewRequested != ""
+	for _, f := range fields {
+		needCI = needCI || f == "ci"
+		needReviews = needReviews || f == "reviews"
+	}
+
+	if !filter.isSet() {
+		prs, resp, err := client.ListRepoPullRequests(owner, repo, opt)
+		if err != nil {
+			return nil, nil, config.ClassifyAPIError(resp, err)
+		}
+		extras, err := listPullExtras(client, owner, repo, prs, needCI, needReviews)
+		if err != nil {
+			return nil, nil, err
+		}
+		return prs, extras, nil
+	}
+
+	limit := opt.PageSize
+	if limit <= 0 {
+		limit = defaultPullsLimit
+	}
+	skip := 0
+	if opt.Page > 1 {
+		skip = (opt.Page - 1) * limit
+	}
+	var matching []*gitea.PullRequest
+	extras := map[*gitea.PullRequest]*print.PullExtras{}
+	var extrasErr error
+	err := ForEachPage(func(page gitea.ListOptions) ([]*gitea.PullRequest, *gitea.Response, error) {
+		opt.ListOptions = page
+		return client.ListRepoPullRequests(owner, repo, opt)
+	}, func(prs []*gitea.PullRequest) bool {
+		var candidates []*gitea.PullRequest
+		for _, pr := range prs {
+			if filter.matches(login, pr) {
+				candidates = append(candidates, pr)
+			}
+		}
+		e, err := listPullExtras(client, owner, repo, candidates, needCI, needReviews)
+		if err != nil {
+			extrasErr = err
+			return false
+		}
+		for _, pr := range candidates {
+			if !filter.matchesExtras(login, e[pr]) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			matching = append(matching, pr)
+			if x, ok := e[pr]; ok {
+				extras[pr] = x
+			}
+			if len(matching) == limit {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if extrasErr != nil {
+		return nil, nil, extrasErr
+	}
+	return matching, extras, nil
+}
+
+// listPullExtras fetches the CI status and reviews of pull requests as needed
+func listPullExtras(client *gitea.Client, owner, repo string, prs []*gitea.PullRequest, needCI, needReviews bool) (map[*gitea.PullRequest]*print.PullExtras, error) {
+	extras := make(map[*gitea.PullRequest]*print.PullExtras, len(prs))
+	if !needCI && !needReviews {
+		return extras, nil
+	}
+
+	sem := make(chan struct{}, listPullsConcurrency)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	errs := make([]error, len(prs))
+	for i, pr := range prs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, pr *gitea.PullRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			e := &print.PullExtras{}
+			if needCI && pr.Head != nil {
+				ci, resp, err := client.GetCombinedStatus(owner, repo, pr.Head.Sha)
+				if err != nil {
+					errs[i] = fmt.Errorf("could not load CI status of #%d: %w", pr.Index, config.ClassifyAPIError(resp, err))
+					return
+				}
+				if len(ci.Statuses) != 0 {
+					e.CI = ci
+				}
+			}
+			if needReviews {
+				reviews, resp, err := client.ListPullReviews(owner, repo, pr.Index, gitea.ListPullReviewsOptions{
+					ListOptions: gitea.ListOptions{Page: -1},
+				})
+				if err != nil {
+					errs[i] = fmt.Errorf("could not load reviews of #%d: %w", pr.Index, config.ClassifyAPIError(resp, err))
+					return
+				}
+				e.Reviews = reviews
+			}
+			mutex.Lock()
+			extras[pr] = e
+			mutex.Unlock()
+		}(i, pr)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return extras, nil
+}
+
+// isDraft reports whether the title marks a pull request as work in progress
+func isDraft(title string) bool {
+	for _, prefix := range draftPrefixes {
+		if len(title) >= len(prefix) && strings.EqualFold(title[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterUser resolves '@me' to the user of login
+func filterUser(login *config.Login, user string) string {
+	if user == "@me" {
+		return login.User
+	}
+	return user
+}
+
+// isSet reports whether any filter is set
+func (f PullFilter) isSet() bool {
+	return f.Author != "" || len(f.Labels) != 0 || f.Base != "" || f.Head != "" ||
+		f.Reviewer != "" || f.ReviewRequested != "" || f.Draft != nil || f.Mergeable != nil || f.CI != ""
+}
+
+// matches applies the filters which need no extras
+func (f PullFilter) matches(login *config.Login, pr *gitea.PullRequest) bool {
+	if f.Author != "" && (pr.Poster == nil || !strings.EqualFold(pr.Poster.UserName, filterUser(login, f.Author))) {
+		return false
+	}
+	if f.Base != "" && (pr.Base == nil || pr.Base.Ref != f.Base) {
+		return false
+	}
+	if f.Head != "" && (pr.Head == nil || pr.Head.Ref != f.Head) {
+		return false
+	}
+	if f.Draft != nil && isDraft(pr.Title) != *f.Draft {
+		return false
+	}
+	if f.Mergeable != nil && (pr.State != gitea.StateOpen || pr.Mergeable != *f.Mergeable) {
+		return false
+	}
+	for _, name := range f.Labels {
+		found := false
+		for _, l := range pr.Labels {
+			if strings.EqualFold(l.Name, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesExtras applies the filters which need the CI status or reviews
+func (f PullFilter) matchesExtras(login *config.Login, e *print.PullExtras) bool {
+	if f.CI != "" {
+		state := "none"
+		if e.CI != nil {
+			state = string(e.CI.State)
+		}
+		if state != f.CI {
+			return false
+		}
+	}
+	if f.Reviewer == "" && f.ReviewRequested == "" {
+		return true
+	}
+
+	// the latest review of a user tells whether their review is still pending
+	reviewer, requested := filterUser(login, f.Reviewer), filterUser(login, f.ReviewRequested)
+	var reviewed bool
+	var latest *gitea.PullReview
+	for _, r := range e.Reviews {
+		if r.Reviewer == nil {
+			continue
+		}
+		if strings.EqualFold(r.Reviewer.UserName, reviewer) && r.State != gitea.ReviewStateRequestReview && r.State != gitea.ReviewStatePending {
+			reviewed = true
+		}
+		if strings.EqualFold(r.Reviewer.UserName, requested) && (latest == nil || r.Submitted.After(latest.Submitted)) {
+			latest = r
+		}
+	}
+	pending := latest != nil && latest.State == gitea.ReviewStateRequestReview
+	return (f.Reviewer == "" || reviewed) && (f.ReviewRequested == "" || pending)
+}
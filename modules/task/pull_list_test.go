@@ -0,0 +1,57 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullFilterMatches(t *testing.T) {
+	login := &config.Login{User: "me"}
+	pr := &gitea.PullRequest{
+		Title:     "[wip] Add feature",
+		State:     gitea.StateOpen,
+		Mergeable: true,
+		Poster:    &gitea.User{UserName: "me"},
+		Labels:    []*gitea.Label{{Name: "feature"}, {Name: "docs"}},
+		Base:      &gitea.PRBranchInfo{Ref: "main"},
+		Head:      &gitea.PRBranchInfo{Ref: "feat"},
+	}
+	yes, no := true, false
+
+	assert.True(t, PullFilter{}.matches(login, pr))
+	assert.True(t, PullFilter{Author: "@me", Labels: []string{"Docs"}, Base: "main", Draft: &yes, Mergeable: &yes}.matches(login, pr))
+	assert.False(t, PullFilter{Author: "alice"}.matches(login, pr))
+	assert.False(t, PullFilter{Labels: []string{"docs", "bug"}}.matches(login, pr))
+	assert.False(t, PullFilter{Head: "main"}.matches(login, pr))
+	assert.False(t, PullFilter{Draft: &no}.matches(login, pr))
+	assert.False(t, PullFilter{Mergeable: &no}.matches(login, pr))
+}
+
+func TestPullFilterMatchesExtras(t *testing.T) {
+	login := &config.Login{User: "me"}
+	now := time.Now()
+	e := &print.PullExtras{
+		CI: &gitea.CombinedStatus{State: gitea.StatusSuccess},
+		Reviews: []*gitea.PullReview{
+			{Reviewer: &gitea.User{UserName: "alice"}, State: gitea.ReviewStateApproved, Submitted: now},
+			{Reviewer: &gitea.User{UserName: "me"}, State: gitea.ReviewStateComment, Submitted: now.Add(-time.Hour)},
+			{Reviewer: &gitea.User{UserName: "me"}, State: gitea.ReviewStateRequestReview, Submitted: now},
+			{Reviewer: &gitea.User{UserName: "bob"}, State: gitea.ReviewStateRequestReview, Submitted: now.Add(-time.Hour)},
+			{Reviewer: &gitea.User{UserName: "bob"}, State: gitea.ReviewStateRequestChanges, Submitted: now},
+		},
+	}
+
+	assert.True(t, PullFilter{CI: "success", Reviewer: "alice", ReviewRequested: "@me"}.matchesExtras(login, e))
+	assert.False(t, PullFilter{CI: "none"}.matchesExtras(login, e))
+	assert.True(t, PullFilter{CI: "none"}.matchesExtras(login, &print.PullExtras{}))
+	assert.False(t, PullFilter{ReviewRequested: "bob"}.matchesExtras(login, e))
+	assert.False(t, PullFilter{Reviewer: "carol"}.matchesExtras(login, e))
+}
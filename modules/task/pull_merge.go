@@ -5,20 +5,216 @@ package task
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/utils"
 )
 
-// PullMerge merges a PR
+// PullMerge merges a PR. Servers before gitea 1.17 ignore
+// DeleteBranchAfterMerge, so the head branch is deleted explicitly there.
 func PullMerge(login *config.Login, repoOwner, repoName string, index int64, opt gitea.MergePullRequestOption) error {
+	deleteBranch := false
+	if opt.DeleteBranchAfterMerge {
+		supported, err := SupportsAutoMerge(login)
+		if err != nil {
+			return err
+		}
+		deleteBranch, opt.DeleteBranchAfterMerge = !supported, supported
+	}
+
 	client := login.Client()
-	success, _, err := client.MergePullRequest(repoOwner, repoName, index, opt)
+	success, resp, err := client.MergePullRequest(repoOwner, repoName, index, opt)
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 	if !success {
 		return fmt.Errorf("Failed to merge PR. Is it still open?")
 	}
+	if !deleteBranch {
+		return nil
+	}
+	pr, resp, err := client.GetPullRequest(repoOwner, repoName, index)
+	if err != nil {
+		return fmt.Errorf("merged #%d, but could not delete its branch: %w", index, config.ClassifyAPIError(resp, err))
+	}
+	if err := DeletePullHeadBranch(login, pr); err != nil {
+		return fmt.Errorf("merged #%d, but %w", index, err)
+	}
+	return nil
+}
+
+func pullMergePath(owner, repo string, index int64) string {
+	return fmt.Sprintf("/repos/%s/%s/pulls/%d/merge", url.PathEscape(owner), url.PathEscape(repo), index)
+}
+
+// SupportsAutoMerge reports whether the server of login can merge pull
+// requests when their checks succeed, and delete their branch after merging
+// (gitea 1.17+). It fails if the version of the server can't be determined.
+func SupportsAutoMerge(login *config.Login) (bool, error) {
+	client := login.Client()
+	// the version check of the client doesn't tell failed requests apart
+	// from old servers
+	if _, resp, err := client.ServerVersion(); err != nil {
+		return false, fmt.Errorf("could not determine the server version: %w", config.ClassifyAPIError(resp, err))
+	}
+	return client.CheckServerVersionConstraint(">= 1.17") == nil, nil
+}
+
+// PullAutoMerge schedules a PR to be merged by the server as soon as its
+// checks succeed, and reports whether it was merged right away instead.
+func PullAutoMerge(login *config.Login, repoOwner, repoName string, index int64, opt gitea.MergePullRequestOption) (bool, error) {
+	opt.MergeWhenChecksSucceed = true
+	_, resp, err := login.Client().MergePullRequest(repoOwner, repoName, index, opt)
+	if err != nil {
+		return false, config.ClassifyAPIError(resp, err)
+	}
+	// the server responds with 201 if the merge was scheduled
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusCreated:
+		return false, nil
+	default:
+		return false, utils.ErrorFromStatus(resp.StatusCode, fmt.Errorf("could not schedule merge of #%d: %s", index, resp.Status))
+	}
+}
+
+// DeletePullHeadBranch deletes the head branch of a merged PR, for servers
+// which ignore DeleteBranchAfterMerge (before gitea 1.17)
+func DeletePullHeadBranch(login *config.Login, pr *gitea.PullRequest) error {
+	if pr.Head == nil || pr.Head.Repository == nil || pr.Head.Repository.Owner == nil {
+		return fmt.Errorf("the head repository of #%d is unknown", pr.Index)
+	}
+	repo := pr.Head.Repository
+	deleted, resp, err := login.Client().DeleteRepoBranch(repo.Owner.UserName, repo.Name, pr.Head.Ref)
+	if err != nil {
+		return fmt.Errorf("could not delete branch '%s' of %s: %w", pr.Head.Ref, repo.FullName, config.ClassifyAPIError(resp, err))
+	}
+	if !deleted {
+		return utils.ErrorFromStatus(resp.StatusCode, fmt.Errorf("could not delete branch '%s' of %s: %s", pr.Head.Ref, repo.FullName, resp.Status))
+	}
 	return nil
 }
+
+// CancelPullAutoMerge cancels the scheduled auto merge of a PR
+func CancelPullAutoMerge(login *config.Login, repoOwner, repoName string, index int64) error {
+	if supported, err := SupportsAutoMerge(login); err != nil {
+		return err
+	} else if !supported {
+		return fmt.Errorf("the server does not support scheduled auto merges")
+	}
+	_, err := login.APIRequest(http.MethodDelete, pullMergePath(repoOwner, repoName, index), nil, nil)
+	return err
+}
+
+// WaitForPullMergeable polls a PR every interval until it has no conflicts,
+// its checks succeeded and it got the approvals required by branch
+// protection. progress is called with what the PR is waiting for, whenever
+// that changes. It fails if a check failed, the PR was closed, or on timeout.
+func WaitForPullMergeable(login *config.Login, repoOwner, repoName string, index int64, interval, timeout time.Duration, progress func(waitingFor string)) error {
+	deadline := time.Now().Add(timeout)
+	var last string
+	for {
+		blockers, err := pullMergeBlockers(login, repoOwner, repoName, index)
+		if err != nil || len(blockers) == 0 {
+			return err
+		}
+		if waitingFor := strings.Join(blockers, ", "); waitingFor != last {
+			progress(waitingFor)
+			last = waitingFor
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for %s", timeout, last)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// pullMergeBlockers returns what keeps a PR from being merged for now, and
+// fails if it can't be merged at all.
+func pullMergeBlockers(login *config.Login, repoOwner, repoName string, index int64) ([]string, error) {
+	client := login.Client()
+	pr, resp, err := client.GetPullRequest(repoOwner, repoName, index)
+	if err != nil {
+		return nil, config.ClassifyAPIError(resp, err)
+	}
+	if pr.State != gitea.StateOpen {
+		return nil, fmt.Errorf("#%d is %s", index, pr.State)
+	}
+
+	// without admin access the branch protection is unknown, so only CI and
+	// conflicts are waited for
+	protection, _ := getBranchProtection(login, repoOwner, repoName, pr.Base.Ref)
+	checks, err := listPullChecks(login, repoOwner, repoName, pr, protection, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockers []string
+	if !pr.Mergeable {
+		blockers = append(blockers, "conflicts to be resolved")
+	}
+	var pending int
+	for _, c := range checks {
+		if protection != nil && protection.EnableStatusCheck && !c.Required {
+			continue
+		}
+		switch c.State {
+		case gitea.StatusFailure, gitea.StatusError:
+			return nil, fmt.Errorf("check '%s' of #%d failed", c.Context, index)
+		case gitea.StatusPending:
+			pending++
+		}
+	}
+	if pending != 0 {
+		blockers = append(blockers, fmt.Sprintf("%d pending checks", pending))
+	}
+
+	if protection != nil && (protection.RequiredApprovals > 0 || protection.BlockOnRejectedReviews) {
+		reviews, resp, err := client.ListPullReviews(repoOwner, repoName, index, gitea.ListPullReviewsOptions{
+			ListOptions: gitea.ListOptions{Page: -1},
+		})
+		if err != nil {
+			return nil, config.ClassifyAPIError(resp, err)
+		}
+		approvals, rejected := countOfficialReviews(reviews, protection.DismissStaleApprovals)
+		if protection.BlockOnRejectedReviews && rejected {
+			blockers = append(blockers, "requested changes to be addressed")
+		}
+		if approvals < protection.RequiredApprovals {
+			blockers = append(blockers, fmt.Sprintf("%d of %d approvals", approvals, protection.RequiredApprovals))
+		}
+	}
+	return blockers, nil
+}
+
+// countOfficialReviews counts the approvals among the latest official review
+// of each reviewer, and reports whether one of them requested changes
+func countOfficialReviews(reviews []*gitea.PullReview, dismissStale bool) (approvals int64, rejected bool) {
+	latest := make(map[int64]*gitea.PullReview)
+	for _, r := range reviews {
+		if r.Reviewer == nil || !r.Official || r.Dismissed {
+			continue
+		}
+		if r.State != gitea.ReviewStateApproved && r.State != gitea.ReviewStateRequestChanges {
+			continue
+		}
+		if prev, ok := latest[r.Reviewer.ID]; !ok || r.Submitted.After(prev.Submitted) {
+			latest[r.Reviewer.ID] = r
+		}
+	}
+	for _, r := range latest {
+		switch {
+		case r.State == gitea.ReviewStateRequestChanges:
+			rejected = true
+		case !dismissStale || !r.Stale:
+			approvals++
+		}
+	}
+	return approvals, rejected
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountOfficialReviews(t *testing.T) {
+	now := time.Now()
+	alice, bob, carol := &gitea.User{ID: 1}, &gitea.User{ID: 2}, &gitea.User{ID: 3}
+	reviews := []*gitea.PullReview{
+		{Reviewer: alice, State: gitea.ReviewStateRequestChanges, Official: true, Submitted: now.Add(-time.Hour)},
+		{Reviewer: alice, State: gitea.ReviewStateApproved, Official: true, Submitted: now},
+		{Reviewer: alice, State: gitea.ReviewStateComment, Official: true, Submitted: now.Add(time.Hour)},
+		{Reviewer: bob, State: gitea.ReviewStateApproved, Official: true, Stale: true, Submitted: now},
+		{Reviewer: carol, State: gitea.ReviewStateApproved, Submitted: now},
+	}
+
+	approvals, rejected := countOfficialReviews(reviews, false)
+	assert.EqualValues(t, 2, approvals)
+	assert.False(t, rejected)
+
+	approvals, _ = countOfficialReviews(reviews, true)
+	assert.EqualValues(t, 1, approvals)
+
+	reviews = append(reviews, &gitea.PullReview{Reviewer: bob, State: gitea.ReviewStateRequestChanges, Official: true, Submitted: now.Add(time.Hour)})
+	approvals, rejected = countOfficialReviews(reviews, false)
+	assert.EqualValues(t, 1, approvals)
+	assert.True(t, rejected)
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"code.gitea.io/tea/modules/config"
 	"code.gitea.io/tea/modules/context"
 
 	"code.gitea.io/sdk/gitea"
@@ -31,13 +32,13 @@ var diffReviewHelp = `# This is the current diff of PR #%d on %s.
 func CreatePullReview(ctx *context.TeaContext, idx int64, status gitea.ReviewStateType, comment string, codeComments []gitea.CreatePullReviewComment) error {
 	c := ctx.Login.Client()
 
-	review, _, err := c.CreatePullReview(ctx.Owner, ctx.Repo, idx, gitea.CreatePullReviewOptions{
+	review, resp, err := c.CreatePullReview(ctx.Owner, ctx.Repo, idx, gitea.CreatePullReviewOptions{
 		State:    status,
 		Body:     comment,
 		Comments: codeComments,
 	})
 	if err != nil {
-		return err
+		return config.ClassifyAPIError(resp, err)
 	}
 
 	fmt.Println(review.HTMLURL)
@@ -47,9 +48,9 @@ func CreatePullReview(ctx *context.TeaContext, idx int64, status gitea.ReviewSta
 // SavePullDiff fetches the diff of a pull request and stores it as a temporary file.
 // The path to the file is returned.
 func SavePullDiff(ctx *context.TeaContext, idx int64) (string, error) {
-	diff, _, err := ctx.Login.Client().GetPullRequestDiff(ctx.Owner, ctx.Repo, idx, gitea.PullRequestDiffOptions{})
+	diff, resp, err := ctx.Login.Client().GetPullRequestDiff(ctx.Owner, ctx.Repo, idx, gitea.PullRequestDiffOptions{})
 	if err != nil {
-		return "", err
+		return "", config.ClassifyAPIError(resp, err)
 	}
 	writer, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("pull-%d-review-*.diff", idx))
 	if err != nil {
@@ -72,13 +73,13 @@ func SavePullDiff(ctx *context.TeaContext, idx int64) (string, error) {
 func ParseDiffComments(diffFile string) ([]gitea.CreatePullReviewComment, error) {
 	reader, err := os.Open(diffFile)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't load diff: %s", err)
+		return nil, fmt.Errorf("couldn't load diff: %w", err)
 	}
 	defer reader.Close()
 
 	changeset, err := unidiff.ReadChangeset(reader)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't parse patch: %s", err)
+		return nil, fmt.Errorf("couldn't parse patch: %w", err)
 	}
 
 	var comments []gitea.CreatePullReviewComment
@@ -10,6 +10,7 @@ import (
 	"code.gitea.io/sdk/gitea"
 	"code.gitea.io/tea/modules/config"
 	local_git "code.gitea.io/tea/modules/git"
+	"code.gitea.io/tea/modules/utils"
 
 	"github.com/go-git/go-git/v5"
 	git_config "github.com/go-git/go-git/v5/config"
@@ -25,9 +26,9 @@ func RepoClone(
 	callback func(string) (string, error),
 	depth int,
 ) (*local_git.TeaRepo, error) {
-	repoMeta, _, err := login.Client().GetRepo(repoOwner, repoName)
+	repoMeta, resp, err := login.Client().GetRepo(repoOwner, repoName)
 	if err != nil {
-		return nil, err
+		return nil, config.ClassifyAPIError(resp, err)
 	}
 
 	originURL, err := cloneURL(repoMeta, login)
@@ -45,6 +46,10 @@ func RepoClone(
 		path = repoName
 	}
 
+	if utils.SkipDryRun("git: clone %s into %s", originURL, path) {
+		return nil, utils.ErrDryRun
+	}
+
 	repo, err := git.PlainClone(path, false, &git.CloneOptions{
 		URL:             originURL.String(),
 		Auth:            auth,
@@ -0,0 +1,128 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package task
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"code.gitea.io/tea/modules/config"
+	"code.gitea.io/tea/modules/print"
+)
+
+// GetUserStatus collects the issues, pull requests and notifications that
+// need the user of login, querying concurrently. owner optionally restricts
+// everything to the repos of a user or organization, and mentions are only
+// included if updated after since. Failing queries are recorded in the
+// Errors of the result, so that the other sections can still be shown.
+func GetUserStatus(login *config.Login, owner string, since time.Time) *print.UserStatus {
+	status := &print.UserStatus{
+		Login:           login.Name,
+		User:            login.User,
+		ReviewRequested: []*print.StatusItem{},
+		OwnPulls:        []*print.StatusItem{},
+		Assigned:        []*print.StatusItem{},
+		Mentioned:       []*print.StatusItem{},
+		Notifications:   []*print.StatusItem{},
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	run := func(section string, query func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := query(); err != nil {
+				mu.Lock()
+				status.Errors = append(status.Errors, fmt.Sprintf("%s: %v", section, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	search := func(target *[]*print.StatusItem, opt SearchIssuesOption) func() error {
+		return func() error {
+			opt.State = gitea.StateOpen
+			opt.Owner = owner
+			issues, err := SearchIssues(login, opt)
+			for _, issue := range issues {
+				*target = append(*target, print.NewStatusItem(issue))
+			}
+			return err
+		}
+	}
+
+	run("review requested", search(&status.ReviewRequested, SearchIssuesOption{Type: gitea.IssueTypePull, ReviewRequested: true}))
+	run("assigned issues", search(&status.Assigned, SearchIssuesOption{Type: gitea.IssueTypeIssue, Assignee: login.User}))
+	run("mentions", search(&status.Mentioned, SearchIssuesOption{Mentioned: true, Since: since}))
+	run("my pull requests", func() error {
+		if err := search(&status.OwnPulls, SearchIssuesOption{Type: gitea.IssueTypePull, Author: login.User})(); err != nil {
+			return err
+		}
+		return addPullStates(login, status.OwnPulls)
+	})
+	run("notifications", func() error {
+		client := login.Client()
+		return ForEachPage(func(opt gitea.ListOptions) ([]*gitea.NotificationThread, *gitea.Response, error) {
+			return client.ListNotifications(gitea.ListNotificationOptions{
+				ListOptions: opt,
+				Status:      []gitea.NotifyStatus{gitea.NotifyStatusUnread},
+			})
+		}, func(threads []*gitea.NotificationThread) bool {
+			for _, n := range threads {
+				if owner == "" || (n.Repository != nil && n.Repository.Owner != nil && strings.EqualFold(n.Repository.Owner.UserName, owner)) {
+					status.Notifications = append(status.Notifications, print.NewNotificationStatusItem(n))
+				}
+			}
+			return true
+		})
+	})
+
+	wg.Wait()
+	return status
+}
+
+// addPullStatesConcurrency limits the number of parallel requests of addPullStates
+const addPullStatesConcurrency = 4
+
+// addPullStates sets the CI and review state of the given pull requests
+func addPullStates(login *config.Login, pulls []*print.StatusItem) error {
+	client := login.Client()
+	sem := make(chan struct{}, addPullStatesConcurrency)
+	var wg sync.WaitGroup
+	errs := make([]error, len(pulls))
+	for i, item := range pulls {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item *print.StatusItem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			repoOwner, repo, _ := strings.Cut(item.Repo, "/")
+			pr, resp, err := client.GetPullRequest(repoOwner, repo, item.Index)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", item.Repo, config.ClassifyAPIError(resp, err))
+				return
+			}
+			if ci, _, err := client.GetCombinedStatus(repoOwner, repo, pr.Head.Sha); err == nil && len(ci.Statuses) != 0 {
+				item.CI = string(ci.State)
+			}
+			reviews, resp, err := client.ListPullReviews(repoOwner, repo, item.Index, gitea.ListPullReviewsOptions{
+				ListOptions: gitea.ListOptions{Page: -1},
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", item.Repo, config.ClassifyAPIError(resp, err))
+				return
+			}
+			item.Review = print.ReviewSummary(reviews)
+		}(i, item)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
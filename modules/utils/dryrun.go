@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+// dryRun disables all mutations, set via --dry-run
+var dryRun bool
+
+// ErrDryRun is returned instead of performing a mutating API request in dry-run mode.
+// Commands should stop (or continue with the next item of a bulk operation) when
+// encountering it; tea then exits successfully.
+var ErrDryRun = errors.New("skipped in dry-run mode")
+
+// SetDryRun enables or disables dry-run mode globally
+func SetDryRun(enabled bool) {
+	dryRun = enabled
+}
+
+// DryRun reports whether dry-run mode is enabled
+func DryRun() bool {
+	return dryRun
+}
+
+// IsDryRun reports whether err was caused by skipping a mutation in dry-run mode
+func IsDryRun(err error) bool {
+	return errors.Is(err, ErrDryRun)
+}
+
+// SkipDryRun prints the planned action described by format & args, if dry-run
+// mode is enabled, and reports whether the action must be skipped therefore.
+func SkipDryRun(format string, args ...any) bool {
+	if !dryRun {
+		return false
+	}
+	fmt.Printf("[dry-run] "+format+"\n", args...)
+	return true
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// Common errors, classifying failures by their cause.
+// Each of them maps to a distinct exit code, see ExitCode().
+var (
+	ErrNotExist         = errors.New("resource does not exist")
+	ErrUnauthorized     = errors.New("authentication failed")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrAlreadyExist     = errors.New("resource already exists")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrNetwork          = errors.New("network error")
+	ErrAborted          = errors.New("aborted by user")
+)
+
+// Exit codes of tea, as documented in the help text.
+const (
+	ExitError            = 1
+	ExitInvalidArgument  = 2
+	ExitNotExist         = 3
+	ExitUnauthorized     = 4
+	ExitPermissionDenied = 5
+	ExitAlreadyExist     = 6
+	ExitNetwork          = 7
+	ExitAborted          = 130
+)
+
+// SilentWrap provides a simple wrapper for a wrapped error where the wrapped error message
+// plays no part in the error message. Especially useful for "untyped" errors created with
+// "errors.New(…)" that can be classified as one of the common errors.
+type SilentWrap struct {
+	Message string
+	Err     error
+}
+
+// Error returns the message
+func (w SilentWrap) Error() string {
+	return w.Message
+}
+
+// Unwrap returns the underlying error
+func (w SilentWrap) Unwrap() error {
+	return w.Err
+}
+
+func newSilentWrap(kind error, format string, args ...any) error {
+	return SilentWrap{Message: fmt.Sprintf(format, args...), Err: kind}
+}
+
+// NewNotExistErrorf returns an error that wraps ErrNotExist
+func NewNotExistErrorf(format string, args ...any) error {
+	return newSilentWrap(ErrNotExist, format, args...)
+}
+
+// NewInvalidArgumentErrorf returns an error that wraps ErrInvalidArgument
+func NewInvalidArgumentErrorf(format string, args ...any) error {
+	return newSilentWrap(ErrInvalidArgument, format, args...)
+}
+
+// NewAlreadyExistErrorf returns an error that wraps ErrAlreadyExist
+func NewAlreadyExistErrorf(format string, args ...any) error {
+	return newSilentWrap(ErrAlreadyExist, format, args...)
+}
+
+// NewAbortedErrorf returns an error that wraps ErrAborted
+func NewAbortedErrorf(format string, args ...any) error {
+	return newSilentWrap(ErrAborted, format, args...)
+}
+
+// ErrorFromStatus classifies err by the HTTP status code of the failed request,
+// keeping its message. Unknown status codes leave err as is.
+func ErrorFromStatus(status int, err error) error {
+	var kind error
+	switch status {
+	case http.StatusUnauthorized:
+		kind = ErrUnauthorized
+	case http.StatusForbidden:
+		kind = ErrPermissionDenied
+	case http.StatusNotFound:
+		kind = ErrNotExist
+	case http.StatusConflict:
+		kind = ErrAlreadyExist
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		kind = ErrInvalidArgument
+	default:
+		return err
+	}
+	return SilentWrap{Message: err.Error(), Err: kind}
+}
+
+// errorKinds maps the common errors to their name and exit code
+var errorKinds = []struct {
+	err  error
+	name string
+	code int
+}{
+	{ErrNotExist, "not-found", ExitNotExist},
+	{ErrUnauthorized, "auth", ExitUnauthorized},
+	{ErrPermissionDenied, "permission", ExitPermissionDenied},
+	{ErrAlreadyExist, "conflict", ExitAlreadyExist},
+	{ErrInvalidArgument, "validation", ExitInvalidArgument},
+	{ErrNetwork, "network", ExitNetwork},
+	{ErrAborted, "user-abort", ExitAborted},
+}
+
+// ErrorKind returns the name of the class of err, and the exit code tea uses for it.
+func ErrorKind(err error) (string, int) {
+	for _, k := range errorKinds {
+		if errors.Is(err, k.err) {
+			return k.name, k.code
+		}
+	}
+
+	// errors that are not wrapped by tea, but can be classified by their type
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return "network", ExitNetwork
+	}
+	if errors.Is(err, terminal.InterruptErr) {
+		return "user-abort", ExitAborted
+	}
+
+	return "error", ExitError
+}
+
+// ExitCode returns the exit code tea uses for err
+func ExitCode(err error) int {
+	_, code := ErrorKind(err)
+	return code
+}
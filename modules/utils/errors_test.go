@@ -0,0 +1,39 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind string
+		code int
+	}{
+		{errors.New("something"), "error", ExitError},
+		{NewNotExistErrorf("issue %d not found", 1), "not-found", ExitNotExist},
+		{fmt.Errorf("wrapped: %w", NewInvalidArgumentErrorf("bad")), "validation", ExitInvalidArgument},
+		{ErrorFromStatus(401, errors.New("token is invalid")), "auth", ExitUnauthorized},
+		{ErrorFromStatus(403, errors.New("forbidden")), "permission", ExitPermissionDenied},
+		{ErrorFromStatus(409, errors.New("exists")), "conflict", ExitAlreadyExist},
+		{ErrorFromStatus(500, errors.New("internal")), "error", ExitError},
+		{&url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")}, "network", ExitNetwork},
+		{NoInputError("--title"), "validation", ExitInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		kind, code := ErrorKind(tt.err)
+		assert.Equal(t, tt.kind, kind, tt.err.Error())
+		assert.Equal(t, tt.code, code, tt.err.Error())
+	}
+
+	assert.Equal(t, "token is invalid", ErrorFromStatus(401, errors.New("token is invalid")).Error())
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+var (
+	// noInput disables all interactive prompts, set via --no-input or $TEA_NO_INPUT
+	noInput bool
+	// assumeYes auto-confirms destructive actions, set via --yes
+	assumeYes bool
+)
+
+// SetNoInput enables or disables interactive prompts globally
+func SetNoInput(disabled bool) {
+	noInput = disabled
+}
+
+// NoInput reports whether interactive prompts are disabled
+func NoInput() bool {
+	return noInput
+}
+
+// SetAssumeYes enables or disables auto-confirmation of destructive actions
+func SetAssumeYes(yes bool) {
+	assumeYes = yes
+}
+
+// AssumeYes reports whether destructive actions should be confirmed without asking
+func AssumeYes() bool {
+	return assumeYes
+}
+
+// NoInputError returns the error for a prompt that can't be shown because input
+// is disabled. flag names the flag or argument that provides the value instead.
+func NoInputError(flag string) error {
+	if flag == "" {
+		return NewInvalidArgumentErrorf("input required, but prompting is disabled by --no-input")
+	}
+	return NewInvalidArgumentErrorf("missing value for %s (prompting is disabled by --no-input)", flag)
+}
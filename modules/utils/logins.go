@@ -0,0 +1,21 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+var (
+	// globalAllLogins and globalLogins are set via the global --all-logins and
+	// --logins flags, which are only supported by some commands
+	globalAllLogins bool
+	globalLogins    string
+)
+
+// SetGlobalLogins sets the values of the global --all-logins and --logins flags
+func SetGlobalLogins(all bool, names string) {
+	globalAllLogins, globalLogins = all, names
+}
+
+// GlobalLogins returns the values of the global --all-logins and --logins flags
+func GlobalLogins() (all bool, names string) {
+	return globalAllLogins, globalLogins
+}
@@ -5,6 +5,7 @@ package utils
 
 import (
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -26,6 +27,85 @@ func ArgToIndex(arg string) (int64, error) {
 	return strconv.ParseInt(strings.TrimPrefix(arg, "#"), 10, 64)
 }
 
+// IndexRef references an issue or pull request by its index,
+// optionally in a specific repo on a specific host.
+type IndexRef struct {
+	Host  string // empty unless given as URL
+	Owner string // empty unless given as URL or cross-repo reference
+	Repo  string
+	Index int64
+}
+
+// RepoSlug returns <owner>/<repo> of the reference, or "" if not set
+func (r IndexRef) RepoSlug() string {
+	if len(r.Owner) == 0 {
+		return ""
+	}
+	return r.Owner + "/" + r.Repo
+}
+
+// indexURLKinds are the URL path segments that are followed by an index.
+// Milestones are left out, as they are referenced by name instead.
+var indexURLKinds = map[string]bool{
+	"issues": true,
+	"pulls":  true,
+}
+
+// validName matches user, org & repo names as allowed by gitea
+var validName = regexp.MustCompile(`^[\w.-]+$`)
+
+// ParseIndexRef parses an index given in one of these formats:
+//
+//	123
+//	#123
+//	owner/repo#123
+//	https://host/owner/repo/issues/123
+//	https://host/owner/repo/pulls/123/files
+//
+// URLs of milestones are not accepted, as milestone arguments are names.
+func ParseIndexRef(arg string) (IndexRef, error) {
+	var ref IndexRef
+	invalid := NewInvalidArgumentErrorf("invalid index '%s': expected a number, owner/repo#<number> or a web URL", arg)
+
+	if strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://") {
+		u, err := url.Parse(arg)
+		if err != nil {
+			return ref, invalid
+		}
+		// search from the back, gitea may be served from a sub path
+		p := strings.Split(strings.Trim(u.Path, "/"), "/")
+		for i := len(p) - 2; i >= 2; i-- {
+			if !indexURLKinds[p[i]] {
+				continue
+			}
+			if ref.Index, err = strconv.ParseInt(p[i+1], 10, 64); err != nil {
+				return ref, invalid
+			}
+			ref.Host, ref.Owner, ref.Repo = u.Host, p[i-2], p[i-1]
+			if !validName.MatchString(ref.Owner) || !validName.MatchString(ref.Repo) {
+				return IndexRef{}, invalid
+			}
+			return ref, nil
+		}
+		return ref, invalid
+	}
+
+	index := arg
+	if slug, idx, ok := strings.Cut(arg, "#"); ok && len(slug) != 0 {
+		owner, repo, ok := strings.Cut(slug, "/")
+		if !ok || !validName.MatchString(owner) || !validName.MatchString(repo) {
+			return ref, invalid
+		}
+		ref.Owner, ref.Repo, index = owner, repo, idx
+	}
+
+	var err error
+	if ref.Index, err = strconv.ParseInt(strings.TrimPrefix(index, "#"), 10, 64); err != nil {
+		return IndexRef{}, invalid
+	}
+	return ref, nil
+}
+
 // NormalizeURL normalizes the input with a protocol
 func NormalizeURL(raw string) (*url.URL, error) {
 	var prefix string
@@ -45,3 +45,36 @@ func TestArgToIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIndexRef(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    IndexRef
+		wantErr bool
+	}{
+		{arg: "12", want: IndexRef{Index: 12}},
+		{arg: "#12", want: IndexRef{Index: 12}},
+		{arg: "gitea/tea#12", want: IndexRef{Owner: "gitea", Repo: "tea", Index: 12}},
+		{arg: "https://gitea.com/gitea/tea/issues/12", want: IndexRef{Host: "gitea.com", Owner: "gitea", Repo: "tea", Index: 12}},
+		{arg: "https://gitea.com/gitea/tea/pulls/45/files", want: IndexRef{Host: "gitea.com", Owner: "gitea", Repo: "tea", Index: 45}},
+		{arg: "http://host:3000/sub/gitea/tea/pulls/45#issuecomment-1", want: IndexRef{Host: "host:3000", Owner: "gitea", Repo: "tea", Index: 45}},
+		{arg: "https://gitea.com/gitea/tea", wantErr: true},
+		{arg: "https://gitea.com/gitea/tea/milestone/3", wantErr: true},
+		{arg: "see gitea/tea#12", wantErr: true},
+		{arg: "tea#12", wantErr: true},
+		{arg: "gitea/tea#abc", wantErr: true},
+		{arg: "feature/branch", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := ParseIndexRef(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIndexRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseIndexRef() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}